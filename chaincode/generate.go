@@ -29,6 +29,21 @@ func ChainCodeFromDeriveIndexUint64(input uint64) []byte {
 
 func ChainCodeFromDeriveIndexString(input string) []byte {
 	val := []byte(input)
-	len := byte(len(val) << 2) // add bitwise shifted length prefix
-	return ChainCodeFromDeriveIndexBytes(append([]byte{len}, val...))
+	return ChainCodeFromDeriveIndexBytes(append(compactLength(len(val)), val...))
+}
+
+// compactLength is the SCALE compact encoding of the length prefix used by substrate to encode strings.
+func compactLength(length int) []byte {
+	switch {
+	case length < 1<<6:
+		return []byte{byte(length << 2)}
+	case length < 1<<14:
+		var bytes [2]byte
+		binary.LittleEndian.PutUint16(bytes[:], uint16(length<<2|0b01))
+		return bytes[:]
+	default:
+		var bytes [4]byte
+		binary.LittleEndian.PutUint32(bytes[:], uint32(length<<2|0b10))
+		return bytes[:]
+	}
 }
@@ -1,6 +1,7 @@
 package chaincode
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/mailchain/go-encoding/encodingtest"
@@ -113,6 +114,13 @@ func TestChainCodeFromDeriveIndexString(t *testing.T) {
 			},
 			encodingtest.MustDecodeHex("79a03475da2fcd8d43e22be1f0f15946f171571506008baa381fecc84373ddea"),
 		},
+		{
+			"two-byte-length-prefix",
+			args{
+				strings.Repeat("a", 70),
+			},
+			encodingtest.MustDecodeHex("6e26c61f0523808079bd93cd097c68aefaafbcfee0d12f2c19d71567e93ff686"),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -6,27 +6,25 @@ import (
 
 	"github.com/mailchain/go-crypto"
 	"github.com/mailchain/go-crypto/cipher"
-	"github.com/mailchain/go-crypto/ed25519"
-	"github.com/mailchain/go-crypto/secp256k1"
-	"github.com/mailchain/go-crypto/sr25519"
+	"github.com/mailchain/go-crypto/multikey"
 )
 
 func pubKeyElements(pubKey crypto.PublicKey) (id byte, data []byte, err error) {
-	switch pk := pubKey.(type) {
-	case *secp256k1.PublicKey:
-		id = crypto.IDSECP256K1
-		data = pk.Bytes()
-	case *ed25519.PublicKey:
-		id = crypto.IDED25519
-		data = pk.Bytes()
-	case *sr25519.PublicKey:
-		id = crypto.IDSR25519
-		data = pk.Bytes()
-	default:
-		err = errors.New("unsupported public key")
+	kind, err := encryptionKeyKindFromPublicKey(pubKey)
+	if err != nil {
+		return 0x0, nil, err
+	}
+
+	return kind.ID, pubKey.Bytes(), nil
+}
+
+func encryptionKeyKindFromPublicKey(pubKey crypto.PublicKey) (multikey.KeyKind, error) {
+	kind, err := multikey.KeyKindFromPublicKey(pubKey)
+	if err != nil || kind.NewKeyExchange == nil {
+		return multikey.KeyKind{}, errors.New("unsupported public key")
 	}
 
-	return
+	return kind, nil
 }
 
 // serializePublicKeyEncryptedContent encode the encrypted data to the hex format
@@ -57,28 +55,26 @@ func serializePrivateKeyEncryptedContent(sealedBox cipher.EncryptedContent, keyI
 
 // deserializePublicKeyEncryptedContent convert the hex format in to the encrypted data format
 func deserializePublicKeyEncryptedContent(raw cipher.EncryptedContent) (cph cipher.EncryptedContent, pubKey crypto.PublicKey, err error) {
+	if len(raw) < 2 {
+		return nil, nil, fmt.Errorf("cipher is too short")
+	}
+
 	if raw[0] != cipher.NACLECDH {
 		return nil, nil, fmt.Errorf("invalid prefix")
 	}
 
-	if len(raw) < 35 {
-		return nil, nil, fmt.Errorf("cipher is too short") // will result in error is less than this
+	kind, ok := multikey.KeyKindByID(raw[1])
+	if !ok || kind.NewKeyExchange == nil {
+		return nil, nil, errors.New("unrecognized pubKeyID")
 	}
 
-	switch raw[1] {
-	case crypto.IDED25519:
-		pubKey, err = ed25519.PublicKeyFromBytes(raw[2:34])
-		cph = raw[34:]
-	case crypto.IDSR25519:
-		pubKey, err = sr25519.PublicKeyFromBytes(raw[2:34])
-		cph = raw[34:]
-	case crypto.IDSECP256K1:
-		pubKey, err = secp256k1.PublicKeyFromBytes(raw[2:35])
-		cph = raw[35:]
-	default:
-		return nil, nil, errors.New("unrecognized pubKeyID")
+	if len(raw) < 2+kind.PublicKeySize {
+		return nil, nil, fmt.Errorf("cipher is too short")
 	}
 
+	pubKey, err = kind.PublicKeyFromBytes(raw[2 : 2+kind.PublicKeySize])
+	cph = raw[2+kind.PublicKeySize:]
+
 	return cph, pubKey, err
 }
 
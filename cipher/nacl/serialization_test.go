@@ -199,6 +199,24 @@ func Test_deserializePublicKeyEncryptedContent(t *testing.T) {
 			nil,
 			true,
 		},
+		{
+			"err-empty",
+			args{
+				cipher.EncryptedContent{},
+			},
+			nil,
+			nil,
+			true,
+		},
+		{
+			"err-ed25519-too-short",
+			args{
+				cipher.EncryptedContent{0x2a, 0xe2, 0x84, 0x62, 0x3e, 0x72, 0x52, 0xe4, 0x11},
+			},
+			nil,
+			nil,
+			true,
+		},
 		{
 			"err-invalid-pubkey-id",
 			args{
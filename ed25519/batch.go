@@ -0,0 +1,115 @@
+package ed25519
+
+import (
+	"crypto/rand"
+	"crypto/sha512"
+	"io"
+
+	"filippo.io/edwards25519"
+)
+
+// BatchEntry is a signature to verify as part of a batch.
+type BatchEntry struct {
+	PublicKey PublicKey
+	Message   []byte
+	Signature []byte
+}
+
+// VerifyBatch verifies all the signatures in entries at once using a randomized multi-scalar multiplication,
+// which for large batches is much faster than calling Verify for every entry.
+// It returns true when all the signatures are valid, otherwise the entries are verified one at a time and
+// valid reports which of the entries have a valid signature.
+//
+// Signatures are checked with the cofactored ZIP-215 rules in the batch and when verifying one at a time, so the
+// result for an entry is the result of VerifyZIP215 whatever the other entries in the batch are.
+// A signature crafted with small order components can be valid in a batch while Verify rejects it,
+// signatures created by Sign are valid in both.
+func VerifyBatch(entries []BatchEntry) (ok bool, valid []bool) {
+	return verifyBatch(rand.Reader, entries)
+}
+
+func verifyBatch(rand io.Reader, entries []BatchEntry) (ok bool, valid []bool) {
+	if batchEquation(rand, entries) {
+		valid = make([]bool, len(entries))
+		for i := range valid {
+			valid[i] = true
+		}
+
+		return true, valid
+	}
+
+	ok = true
+	valid = make([]bool, len(entries))
+
+	for i, e := range entries {
+		valid[i] = e.PublicKey.VerifyZIP215(e.Message, e.Signature)
+		ok = ok && valid[i]
+	}
+
+	return ok, valid
+}
+
+// batchEquation checks [8](-∑ zᵢsᵢ)B + [8]∑ zᵢRᵢ + [8]∑ (zᵢkᵢ)Aᵢ = 0 for random 128 bit zᵢ.
+func batchEquation(rand io.Reader, entries []BatchEntry) bool {
+	scalars := make([]*edwards25519.Scalar, 1, 1+2*len(entries))
+	points := make([]*edwards25519.Point, 1, 1+2*len(entries))
+	scalars[0] = edwards25519.NewScalar()
+	points[0] = edwards25519.NewGeneratorPoint()
+
+	zkScalars := make([]*edwards25519.Scalar, 0, len(entries))
+	aPoints := make([]*edwards25519.Point, 0, len(entries))
+
+	for _, e := range entries {
+		if len(e.PublicKey.Key) != PublicKeySize || len(e.Signature) != SignatureSize {
+			return false
+		}
+
+		a, err := new(edwards25519.Point).SetBytes(e.PublicKey.Key)
+		if err != nil {
+			return false
+		}
+
+		r, err := new(edwards25519.Point).SetBytes(e.Signature[:32])
+		if err != nil {
+			return false
+		}
+
+		s, err := edwards25519.NewScalar().SetCanonicalBytes(e.Signature[32:])
+		if err != nil {
+			return false
+		}
+
+		h := sha512.New()
+		h.Write(e.Signature[:32])
+		h.Write(e.PublicKey.Key)
+		h.Write(e.Message)
+
+		k, err := edwards25519.NewScalar().SetUniformBytes(h.Sum(nil))
+		if err != nil {
+			return false
+		}
+
+		zBytes := make([]byte, 32)
+		if _, err := io.ReadFull(rand, zBytes[:16]); err != nil {
+			return false
+		}
+
+		z, err := edwards25519.NewScalar().SetCanonicalBytes(zBytes)
+		if err != nil {
+			return false
+		}
+
+		scalars[0].Subtract(scalars[0], s.Multiply(z, s))
+		scalars = append(scalars, z)
+		points = append(points, r)
+		zkScalars = append(zkScalars, k.Multiply(z, k))
+		aPoints = append(aPoints, a)
+	}
+
+	scalars = append(scalars, zkScalars...)
+	points = append(points, aPoints...)
+
+	check := new(edwards25519.Point).VarTimeMultiScalarMult(scalars, points)
+
+	return check.MultByCofactor(check).Equal(edwards25519.NewIdentityPoint()) == 1
+}
@@ -0,0 +1,151 @@
+package ed25519
+
+import (
+	"bytes"
+	"crypto/rand"
+	"fmt"
+	"testing"
+
+	"github.com/mailchain/go-encoding/encodingtest"
+	"github.com/stretchr/testify/assert"
+)
+
+func newBatchEntries(t testing.TB, n int) []BatchEntry {
+	entries := make([]BatchEntry, n)
+
+	for i := range entries {
+		key, err := GenerateKey(rand.Reader)
+		if !assert.NoError(t, err) {
+			t.FailNow()
+		}
+
+		message := []byte(fmt.Sprintf("message %d", i))
+		sig, err := key.Sign(message)
+		if !assert.NoError(t, err) {
+			t.FailNow()
+		}
+
+		entries[i] = BatchEntry{PublicKey: *key.PublicKey().(*PublicKey), Message: message, Signature: sig}
+	}
+
+	return entries
+}
+
+func TestVerifyBatch(t *testing.T) {
+	entries := newBatchEntries(t, 32)
+
+	ok, valid := VerifyBatch(entries)
+	assert.True(t, ok)
+	assert.Len(t, valid, 32)
+	assert.NotContains(t, valid, false)
+
+	ok, valid = VerifyBatch(nil)
+	assert.True(t, ok)
+	assert.Empty(t, valid)
+}
+
+func TestVerifyBatchInvalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(entries []BatchEntry)
+	}{
+		{
+			"wrong-message",
+			func(entries []BatchEntry) { entries[3].Message = []byte("other") },
+		},
+		{
+			"wrong-key",
+			func(entries []BatchEntry) { entries[3].PublicKey = entries[4].PublicKey },
+		},
+		{
+			"swapped-signatures",
+			func(entries []BatchEntry) {
+				entries[3].Signature, entries[7].Signature = entries[7].Signature, entries[3].Signature
+			},
+		},
+		{
+			"short-signature",
+			func(entries []BatchEntry) { entries[3].Signature = entries[3].Signature[:63] },
+		},
+		{
+			"non-canonical-s",
+			func(entries []BatchEntry) {
+				sig := append([]byte{}, entries[3].Signature...)
+				sig[63] |= 0xf0
+				entries[3].Signature = sig
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entries := newBatchEntries(t, 16)
+			tt.modify(entries)
+
+			ok, valid := VerifyBatch(entries)
+			assert.False(t, ok)
+			for i, v := range valid {
+				want := entries[i].PublicKey.VerifyZIP215(entries[i].Message, entries[i].Signature)
+				assert.Equal(t, want, v, "entry %d", i)
+			}
+			assert.False(t, valid[3])
+			assert.True(t, valid[0])
+		})
+	}
+}
+
+func TestVerifyBatchRandError(t *testing.T) {
+	entries := newBatchEntries(t, 4)
+
+	ok, valid := verifyBatch(bytes.NewReader(nil), entries)
+	assert.True(t, ok)
+	assert.Equal(t, []bool{true, true, true, true}, valid)
+}
+
+func TestVerifyBatchSmallOrder(t *testing.T) {
+	// speccheck case 4, valid with the cofactored equation and rejected by the cofactorless Verify.
+	smallOrder := BatchEntry{
+		PublicKey: PublicKey{Key: encodingtest.MustDecodeHex("cdb267ce40c5cd45306fa5d2f29731459387dbf9eb933b7bd5aed9a765b88d4d")},
+		Message:   encodingtest.MustDecodeHex("e47d62c63f830dc7a6851a0b1f33ae4bb2f507fb6cffec4011eaccd55b53f56c"),
+		Signature: encodingtest.MustDecodeHex("160a1cb0dc9c0258cd0a7d23e94d8fa878bcb1925f2c64246b2dee1796bed5125ec6bc982a269b723e0668e540911a9a6a58921d6925e434ab10aa7940551a09"),
+	}
+	assert.False(t, smallOrder.PublicKey.Verify(smallOrder.Message, smallOrder.Signature))
+
+	entries := newBatchEntries(t, 8)
+	entries[2] = smallOrder
+
+	ok, valid := VerifyBatch(entries)
+	assert.True(t, ok)
+	assert.True(t, valid[2])
+
+	entries[5].Message = []byte("other")
+
+	ok, valid = VerifyBatch(entries)
+	assert.False(t, ok)
+	assert.True(t, valid[2])
+	assert.False(t, valid[5])
+	assert.True(t, valid[0])
+}
+
+func BenchmarkVerifyBatch(b *testing.B) {
+	for _, n := range []int{1, 8, 64, 256} {
+		entries := newBatchEntries(b, n)
+
+		b.Run(fmt.Sprintf("batch-%d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				if ok, _ := VerifyBatch(entries); !ok {
+					b.Fatal("batch verification failed")
+				}
+			}
+		})
+
+		b.Run(fmt.Sprintf("verify-%d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				for _, e := range entries {
+					if !e.PublicKey.Verify(e.Message, e.Signature) {
+						b.Fatal("verification failed")
+					}
+				}
+			}
+		})
+	}
+}
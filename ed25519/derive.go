@@ -27,6 +27,8 @@ func seedBytes(parent crypto.PrivateKey) ([]byte, error) {
 	switch edKey := parent.(type) {
 	case *PrivateKey:
 		return edKey.Key.Seed(), nil
+	case PrivateKey:
+		return edKey.Key.Seed(), nil
 	default:
 		return nil, errors.New("unknown private key type")
 	}
@@ -0,0 +1,147 @@
+package ed25519
+
+import (
+	"errors"
+
+	"github.com/mailchain/go-crypto"
+	"github.com/mailchain/go-crypto/internal/hdkey"
+)
+
+// HardenedKeyStart is the index at which hardened SLIP-0010 keys begin.
+// ed25519 only supports hardened derivation so every index must be equal to or greater than this value.
+const HardenedKeyStart = hdkey.HardenedKeyStart
+
+const (
+	minSeedLength = 16
+	maxSeedLength = 64
+)
+
+var masterKeySalt = []byte("ed25519 seed") //nolint: gochecknoglobals
+
+var (
+	// ErrInvalidSeedLength is returned when the master seed is not between 128 and 512 bits.
+	ErrInvalidSeedLength = errors.New("seed length must be between 128 and 512 bits")
+	// ErrNonHardenedDerivation is returned when a non-hardened child is requested, SLIP-0010 only defines hardened derivation for ed25519.
+	ErrNonHardenedDerivation = errors.New("ed25519 only supports hardened derivation")
+	// ErrDeriveHardenedFromPublic is returned when a child is requested from an extended public key.
+	ErrDeriveHardenedFromPublic = hdkey.ErrDeriveHardenedFromPublic
+	// ErrMaxDepth is returned when deriving beyond the maximum depth.
+	ErrMaxDepth = hdkey.ErrMaxDepth
+	// ErrInvalidExtendedKey is returned when a serialized extended key is malformed.
+	ErrInvalidExtendedKey = hdkey.ErrInvalidExtendedKey
+)
+
+// ExtendedPrivateKey is a SLIP-0010 hierarchical deterministic private key based on the ed25519 curve.
+type ExtendedPrivateKey struct {
+	meta hdkey.Metadata
+	key  PrivateKey
+}
+
+// NewExtendedPrivateKeyFromSeed creates the SLIP-0010 master extended private key from a seed.
+// The seed must be between 16 and 64 bytes, a 64 byte seed is recommended.
+func NewExtendedPrivateKeyFromSeed(seed []byte) (*ExtendedPrivateKey, error) {
+	if len(seed) < minSeedLength || len(seed) > maxSeedLength {
+		return nil, ErrInvalidSeedLength
+	}
+
+	il, ir := hdkey.HMACSHA512(masterKeySalt, seed)
+
+	key, err := PrivateKeyFromBytes(il)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ExtendedPrivateKey{meta: hdkey.Master(ir), key: *key}, nil
+}
+
+// ExtendedPrivateKeyFromBytes creates an extended private key from the 74 byte serialization returned by Bytes.
+func ExtendedPrivateKeyFromBytes(in []byte) (*ExtendedPrivateKey, error) {
+	meta, keyData, err := hdkey.Deserialize(in, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if keyData[0] != 0x00 {
+		return nil, ErrInvalidExtendedKey
+	}
+
+	key, err := PrivateKeyFromBytes(keyData[1:])
+	if err != nil {
+		return nil, ErrInvalidExtendedKey
+	}
+
+	return &ExtendedPrivateKey{meta: meta, key: *key}, nil
+}
+
+// Bytes returns the BIP-32 layout of the extended private key without the version prefix,
+// SLIP-0010 does not define version bytes for ed25519.
+func (k ExtendedPrivateKey) Bytes() []byte {
+	return k.meta.Serialize(nil, append([]byte{0x00}, k.key.Key.Seed()...))
+}
+
+// PrivateKey returns the private key.
+func (k ExtendedPrivateKey) PrivateKey() crypto.PrivateKey {
+	return &k.key
+}
+
+// ChainCode returns the chain code used to derive child keys.
+func (k ExtendedPrivateKey) ChainCode() []byte {
+	return k.meta.ChainCode
+}
+
+// Depth returns the number of derivations from the master key, the master key has a depth of 0.
+func (k ExtendedPrivateKey) Depth() uint8 {
+	return k.meta.Depth
+}
+
+// ChildNumber returns the index that was used to derive this key from its parent.
+func (k ExtendedPrivateKey) ChildNumber() uint32 {
+	return k.meta.ChildNumber
+}
+
+// Fingerprint returns the fingerprint of this key, it is the parent fingerprint of its children.
+func (k ExtendedPrivateKey) Fingerprint() []byte {
+	return hdkey.Fingerprint(slip10PublicKey(k.key.PublicKey().Bytes()))
+}
+
+// ParentFingerprint returns the fingerprint of the parent key, the master key has a parent fingerprint of 0x00000000.
+func (k ExtendedPrivateKey) ParentFingerprint() []byte {
+	return k.meta.ParentFingerprint
+}
+
+// Derive the hardened child extended private key at index.
+// ErrNonHardenedDerivation is returned when index is less than HardenedKeyStart.
+func (k ExtendedPrivateKey) Derive(index uint32) (crypto.ExtendedPrivateKey, error) {
+	return k.derive(index)
+}
+
+func (k ExtendedPrivateKey) derive(index uint32) (*ExtendedPrivateKey, error) {
+	if !hdkey.IsHardened(index) {
+		return nil, ErrNonHardenedDerivation
+	}
+
+	data := append([]byte{0x00}, k.key.Key.Seed()...)
+	il, ir := hdkey.HMACSHA512(k.meta.ChainCode, append(data, hdkey.Uint32Bytes(index)...))
+
+	childKey, err := PrivateKeyFromBytes(il)
+	if err != nil {
+		return nil, err
+	}
+
+	childMeta, err := k.meta.Child(slip10PublicKey(k.key.PublicKey().Bytes()), index, ir)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ExtendedPrivateKey{meta: childMeta, key: *childKey}, nil
+}
+
+// ExtendedPublicKey returns the extended public key that corresponds to this extended private key.
+func (k ExtendedPrivateKey) ExtendedPublicKey() (crypto.ExtendedPublicKey, error) {
+	return &ExtendedPublicKey{meta: k.meta, key: *k.key.PublicKey().(*PublicKey)}, nil
+}
+
+// slip10PublicKey returns the 33 byte public key representation used by SLIP-0010 for fingerprints.
+func slip10PublicKey(publicKey []byte) []byte {
+	return append([]byte{0x00}, publicKey...)
+}
@@ -0,0 +1,174 @@
+package ed25519
+
+import (
+	"testing"
+
+	"github.com/mailchain/go-encoding/encodingtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Test vectors from https://github.com/satoshilabs/slips/blob/master/slip-0010.md#test-vector-1-for-ed25519
+func TestExtendedPrivateKey_Derive(t *testing.T) {
+	tests := []struct {
+		name                  string
+		seed                  []byte
+		path                  []uint32
+		wantParentFingerprint []byte
+		wantChainCode         []byte
+		wantPrivateKey        []byte
+		wantPublicKey         []byte
+	}{
+		{
+			"vector-1-m",
+			encodingtest.MustDecodeHex("000102030405060708090a0b0c0d0e0f"),
+			[]uint32{},
+			encodingtest.MustDecodeHex("00000000"),
+			encodingtest.MustDecodeHex("90046a93de5380a72b5e45010748567d5ea02bbf6522f979e05c0d8d8ca9fffb"),
+			encodingtest.MustDecodeHex("2b4be7f19ee27bbf30c667b642d5f4aa69fd169872f8fc3059c08ebae2eb19e7"),
+			encodingtest.MustDecodeHex("a4b2856bfec510abab89753fac1ac0e1112364e7d250545963f135f2a33188ed"),
+		},
+		{
+			"vector-1-m/0H",
+			encodingtest.MustDecodeHex("000102030405060708090a0b0c0d0e0f"),
+			[]uint32{HardenedKeyStart},
+			encodingtest.MustDecodeHex("ddebc675"),
+			encodingtest.MustDecodeHex("8b59aa11380b624e81507a27fedda59fea6d0b779a778918a2fd3590e16e9c69"),
+			encodingtest.MustDecodeHex("68e0fe46dfb67e368c75379acec591dad19df3cde26e63b93a8e704f1dade7a3"),
+			encodingtest.MustDecodeHex("8c8a13df77a28f3445213a0f432fde644acaa215fc72dcdf300d5efaa85d350c"),
+		},
+		{
+			"vector-1-m/0H/1H",
+			encodingtest.MustDecodeHex("000102030405060708090a0b0c0d0e0f"),
+			[]uint32{HardenedKeyStart, HardenedKeyStart + 1},
+			encodingtest.MustDecodeHex("13dab143"),
+			encodingtest.MustDecodeHex("a320425f77d1b5c2505a6b1b27382b37368ee640e3557c315416801243552f14"),
+			encodingtest.MustDecodeHex("b1d0bad404bf35da785a64ca1ac54b2617211d2777696fbffaf208f746ae84f2"),
+			encodingtest.MustDecodeHex("1932a5270f335bed617d5b935c80aedb1a35bd9fc1e31acafd5372c30f5c1187"),
+		},
+		{
+			"vector-1-m/0H/1H/2H",
+			encodingtest.MustDecodeHex("000102030405060708090a0b0c0d0e0f"),
+			[]uint32{HardenedKeyStart, HardenedKeyStart + 1, HardenedKeyStart + 2},
+			encodingtest.MustDecodeHex("ebe4cb29"),
+			encodingtest.MustDecodeHex("2e69929e00b5ab250f49c3fb1c12f252de4fed2c1db88387094a0f8c4c9ccd6c"),
+			encodingtest.MustDecodeHex("92a5b23c0b8a99e37d07df3fb9966917f5d06e02ddbd909c7e184371463e9fc9"),
+			encodingtest.MustDecodeHex("ae98736566d30ed0e9d2f4486a64bc95740d89c7db33f52121f8ea8f76ff0fc1"),
+		},
+		{
+			"vector-1-m/0H/1H/2H/2H",
+			encodingtest.MustDecodeHex("000102030405060708090a0b0c0d0e0f"),
+			[]uint32{HardenedKeyStart, HardenedKeyStart + 1, HardenedKeyStart + 2, HardenedKeyStart + 2},
+			encodingtest.MustDecodeHex("316ec1c6"),
+			encodingtest.MustDecodeHex("8f6d87f93d750e0efccda017d662a1b31a266e4a6f5993b15f5c1f07f74dd5cc"),
+			encodingtest.MustDecodeHex("30d1dc7e5fc04c31219ab25a27ae00b50f6fd66622f6e9c913253d6511d1e662"),
+			encodingtest.MustDecodeHex("8abae2d66361c879b900d204ad2cc4984fa2aa344dd7ddc46007329ac76c429c"),
+		},
+		{
+			"vector-1-m/0H/1H/2H/2H/1000000000H",
+			encodingtest.MustDecodeHex("000102030405060708090a0b0c0d0e0f"),
+			[]uint32{HardenedKeyStart, HardenedKeyStart + 1, HardenedKeyStart + 2, HardenedKeyStart + 2, HardenedKeyStart + 1000000000},
+			encodingtest.MustDecodeHex("d6322ccd"),
+			encodingtest.MustDecodeHex("68789923a0cac2cd5a29172a475fe9e0fb14cd6adb5ad98a3fa70333e7afa230"),
+			encodingtest.MustDecodeHex("8f94d394a8e8fd6b1bc2f3f49f5c47e385281d5c17e65324b0f62483e37e8793"),
+			encodingtest.MustDecodeHex("3c24da049451555d51a7014a37337aa4e12d41e485abccfa46b47dfb2af54b7a"),
+		},
+		{
+			"vector-2-m",
+			encodingtest.MustDecodeHex("fffcf9f6f3f0edeae7e4e1dedbd8d5d2cfccc9c6c3c0bdbab7b4b1aeaba8a5a29f9c999693908d8a8784817e7b7875726f6c696663605d5a5754514e4b484542"),
+			[]uint32{},
+			encodingtest.MustDecodeHex("00000000"),
+			encodingtest.MustDecodeHex("ef70a74db9c3a5af931b5fe73ed8e1a53464133654fd55e7a66f8570b8e33c3b"),
+			encodingtest.MustDecodeHex("171cb88b1b3c1db25add599712e36245d75bc65a1a5c9e18d76f9f2b1eab4012"),
+			encodingtest.MustDecodeHex("8fe9693f8fa62a4305a140b9764c5ee01e455963744fe18204b4fb948249308a"),
+		},
+		{
+			"vector-2-m/0H",
+			encodingtest.MustDecodeHex("fffcf9f6f3f0edeae7e4e1dedbd8d5d2cfccc9c6c3c0bdbab7b4b1aeaba8a5a29f9c999693908d8a8784817e7b7875726f6c696663605d5a5754514e4b484542"),
+			[]uint32{HardenedKeyStart},
+			encodingtest.MustDecodeHex("31981b50"),
+			encodingtest.MustDecodeHex("0b78a3226f915c082bf118f83618a618ab6dec793752624cbeb622acb562862d"),
+			encodingtest.MustDecodeHex("1559eb2bbec5790b0c65d8693e4d0875b1747f4970ae8b650486ed7470845635"),
+			encodingtest.MustDecodeHex("86fab68dcb57aa196c77c5f264f215a112c22a912c10d123b0d03c3c28ef1037"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key, err := NewExtendedPrivateKeyFromSeed(tt.seed)
+			require.NoError(t, err)
+
+			for _, index := range tt.path {
+				child, err := key.Derive(index)
+				require.NoError(t, err)
+				key = child.(*ExtendedPrivateKey)
+			}
+
+			assert.Equal(t, tt.wantParentFingerprint, key.ParentFingerprint())
+			assert.Equal(t, tt.wantChainCode, key.ChainCode())
+			assert.Equal(t, tt.wantPrivateKey, key.PrivateKey().(*PrivateKey).Key.Seed())
+			assert.Equal(t, tt.wantPublicKey, key.PrivateKey().PublicKey().Bytes())
+			assert.Equal(t, uint8(len(tt.path)), key.Depth())
+		})
+	}
+}
+
+func TestExtendedPrivateKey_DeriveErrors(t *testing.T) {
+	key, err := NewExtendedPrivateKeyFromSeed(encodingtest.MustDecodeHex("000102030405060708090a0b0c0d0e0f"))
+	require.NoError(t, err)
+
+	_, err = key.Derive(0)
+	assert.Equal(t, ErrNonHardenedDerivation, err)
+
+	key.meta.Depth = 255
+	_, err = key.Derive(HardenedKeyStart)
+	assert.Equal(t, ErrMaxDepth, err)
+}
+
+func TestNewExtendedPrivateKeyFromSeed(t *testing.T) {
+	tests := []struct {
+		name    string
+		seed    []byte
+		wantErr error
+	}{
+		{
+			"success-16",
+			make([]byte, 16),
+			nil,
+		},
+		{
+			"err-short",
+			make([]byte, 15),
+			ErrInvalidSeedLength,
+		},
+		{
+			"err-long",
+			make([]byte, 65),
+			ErrInvalidSeedLength,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewExtendedPrivateKeyFromSeed(tt.seed)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestExtendedPrivateKeyFromBytes(t *testing.T) {
+	key, err := NewExtendedPrivateKeyFromSeed(encodingtest.MustDecodeHex("000102030405060708090a0b0c0d0e0f"))
+	require.NoError(t, err)
+	child, err := key.Derive(HardenedKeyStart)
+	require.NoError(t, err)
+
+	got, err := ExtendedPrivateKeyFromBytes(child.Bytes())
+	require.NoError(t, err)
+	assert.Equal(t, child, got)
+
+	_, err = ExtendedPrivateKeyFromBytes(child.Bytes()[1:])
+	assert.Error(t, err)
+
+	invalidPrefix := child.Bytes()
+	invalidPrefix[41] = 0x01
+	_, err = ExtendedPrivateKeyFromBytes(invalidPrefix)
+	assert.Equal(t, ErrInvalidExtendedKey, err)
+}
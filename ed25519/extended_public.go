@@ -0,0 +1,77 @@
+package ed25519
+
+import (
+	"github.com/mailchain/go-crypto"
+	"github.com/mailchain/go-crypto/internal/hdkey"
+)
+
+// ExtendedPublicKey is a SLIP-0010 hierarchical deterministic public key based on the ed25519 curve.
+// SLIP-0010 does not define public derivation for ed25519, children can only be derived from the ExtendedPrivateKey.
+type ExtendedPublicKey struct {
+	meta hdkey.Metadata
+	key  PublicKey
+}
+
+// ExtendedPublicKeyFromBytes creates an extended public key from the 74 byte serialization returned by Bytes.
+func ExtendedPublicKeyFromBytes(in []byte) (*ExtendedPublicKey, error) {
+	meta, keyData, err := hdkey.Deserialize(in, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if keyData[0] != 0x00 {
+		return nil, ErrInvalidExtendedKey
+	}
+
+	key, err := PublicKeyFromBytes(keyData[1:])
+	if err != nil {
+		return nil, ErrInvalidExtendedKey
+	}
+
+	return &ExtendedPublicKey{meta: meta, key: *key}, nil
+}
+
+// Bytes returns the BIP-32 layout of the extended public key without the version prefix,
+// SLIP-0010 does not define version bytes for ed25519.
+func (k ExtendedPublicKey) Bytes() []byte {
+	return k.meta.Serialize(nil, slip10PublicKey(k.key.Bytes()))
+}
+
+// PublicKey returns the public key.
+func (k ExtendedPublicKey) PublicKey() crypto.PublicKey {
+	return &k.key
+}
+
+// ChainCode returns the chain code.
+func (k ExtendedPublicKey) ChainCode() []byte {
+	return k.meta.ChainCode
+}
+
+// Depth returns the number of derivations from the master key, the master key has a depth of 0.
+func (k ExtendedPublicKey) Depth() uint8 {
+	return k.meta.Depth
+}
+
+// ChildNumber returns the index that was used to derive this key from its parent.
+func (k ExtendedPublicKey) ChildNumber() uint32 {
+	return k.meta.ChildNumber
+}
+
+// Fingerprint returns the fingerprint of this key, it is the parent fingerprint of its children.
+func (k ExtendedPublicKey) Fingerprint() []byte {
+	return hdkey.Fingerprint(slip10PublicKey(k.key.Bytes()))
+}
+
+// ParentFingerprint returns the fingerprint of the parent key, the master key has a parent fingerprint of 0x00000000.
+func (k ExtendedPublicKey) ParentFingerprint() []byte {
+	return k.meta.ParentFingerprint
+}
+
+// Derive always returns an error as ed25519 only supports hardened derivation which requires the private key.
+func (k ExtendedPublicKey) Derive(index uint32) (crypto.ExtendedPublicKey, error) {
+	if !hdkey.IsHardened(index) {
+		return nil, ErrNonHardenedDerivation
+	}
+
+	return nil, ErrDeriveHardenedFromPublic
+}
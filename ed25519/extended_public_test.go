@@ -0,0 +1,31 @@
+package ed25519
+
+import (
+	"testing"
+
+	"github.com/mailchain/go-encoding/encodingtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtendedPublicKey(t *testing.T) {
+	key, err := NewExtendedPrivateKeyFromSeed(encodingtest.MustDecodeHex("000102030405060708090a0b0c0d0e0f"))
+	require.NoError(t, err)
+	child, err := key.Derive(HardenedKeyStart)
+	require.NoError(t, err)
+
+	pub, err := child.ExtendedPublicKey()
+	require.NoError(t, err)
+	assert.Equal(t, encodingtest.MustDecodeHex("8c8a13df77a28f3445213a0f432fde644acaa215fc72dcdf300d5efaa85d350c"), pub.PublicKey().Bytes())
+	assert.Equal(t, encodingtest.MustDecodeHex("ddebc675"), pub.(*ExtendedPublicKey).ParentFingerprint())
+	assert.Equal(t, child.(*ExtendedPrivateKey).Fingerprint(), pub.(*ExtendedPublicKey).Fingerprint())
+
+	got, err := ExtendedPublicKeyFromBytes(pub.Bytes())
+	require.NoError(t, err)
+	assert.Equal(t, pub, got)
+
+	_, err = pub.Derive(0)
+	assert.Equal(t, ErrNonHardenedDerivation, err)
+	_, err = pub.Derive(HardenedKeyStart)
+	assert.Equal(t, ErrDeriveHardenedFromPublic, err)
+}
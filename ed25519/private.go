@@ -1,6 +1,7 @@
 package ed25519
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"io"
@@ -36,13 +37,20 @@ func (pk PrivateKey) PublicKey() crypto.PublicKey {
 	return &PublicKey{Key: publicKey}
 }
 
-// PrivateKeyFromBytes get a private key from seed []byte
+// PrivateKeyFromBytes get a private key from the 32 byte seed or the 64 byte seed and public key.
+// The public key of the 64 byte form must be the public key of the seed, otherwise signatures would not match
+// the public key of the private key.
 func PrivateKeyFromBytes(privKey []byte) (*PrivateKey, error) {
 	switch len(privKey) {
 	case ed25519.SeedSize:
 		return &PrivateKey{Key: ed25519.NewKeyFromSeed(privKey)}, nil
 	case ed25519.PrivateKeySize:
-		return &PrivateKey{Key: privKey}, nil
+		key := ed25519.NewKeyFromSeed(privKey[:ed25519.SeedSize])
+		if !bytes.Equal(key[ed25519.SeedSize:], privKey[ed25519.SeedSize:]) {
+			return nil, fmt.Errorf("ed25519: public key does not match seed")
+		}
+
+		return &PrivateKey{Key: key}, nil
 	default:
 		return nil, fmt.Errorf("ed25519: bad key length")
 	}
@@ -88,6 +88,14 @@ func TestPrivateKeyFromBytes(t *testing.T) {
 			nil,
 			true,
 		},
+		{
+			"err-mismatched-public-key",
+			args{
+				append(append([]byte{}, aliceSeed...), bobPublicKeyBytes...),
+			},
+			nil,
+			true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
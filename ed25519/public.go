@@ -12,6 +12,8 @@ type PublicKey struct {
 }
 
 // Verify verifies whether sig is a valid signature of message.
+// Non canonical and small order points are accepted, use VerifyStrict when signatures must not be malleable
+// or VerifyZIP215 when the result must agree with other ZIP-215 implementations.
 func (pk PublicKey) Verify(message, sig []byte) bool {
 	return ed25519.Verify(pk.Key, message, sig)
 }
@@ -0,0 +1,39 @@
+package ed25519
+
+import (
+	"bytes"
+
+	"filippo.io/edwards25519"
+	"golang.org/x/crypto/ed25519"
+)
+
+// VerifyStrict verifies whether sig is a valid signature of message, only accepting a single encoding of each
+// signature and public key.
+// It is the recommended verification where every party must agree on which signatures are valid, for example consensus.
+// In addition to the checks of Verify, the public key and R must be canonically encoded points that are not of small
+// order, so a signature can not be valid for more than one message or key.
+func (pk PublicKey) VerifyStrict(message, sig []byte) bool {
+	if len(pk.Key) != PublicKeySize || len(sig) != SignatureSize {
+		return false
+	}
+
+	if !isStrictPoint(pk.Key) || !isStrictPoint(sig[:32]) {
+		return false
+	}
+
+	if _, err := edwards25519.NewScalar().SetCanonicalBytes(sig[32:]); err != nil {
+		return false
+	}
+
+	return ed25519.Verify(pk.Key, message, sig)
+}
+
+// isStrictPoint returns true when b is the canonical encoding of a point that is not of small order.
+func isStrictPoint(b []byte) bool {
+	p, err := new(edwards25519.Point).SetBytes(b)
+	if err != nil || !bytes.Equal(p.Bytes(), b) {
+		return false
+	}
+
+	return new(edwards25519.Point).MultByCofactor(p).Equal(edwards25519.NewIdentityPoint()) != 1
+}
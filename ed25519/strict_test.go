@@ -0,0 +1,108 @@
+package ed25519
+
+import (
+	"testing"
+
+	"github.com/mailchain/go-crypto"
+	"github.com/mailchain/go-encoding/encodingtest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPublicKey_VerifyStrict(t *testing.T) {
+	message := []byte("message")
+
+	sig, err := alicePrivateKey.Sign(message)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	identity := encodingtest.MustDecodeHex("0100000000000000000000000000000000000000000000000000000000000000")
+	// the identity with y encoded as p + 1
+	nonCanonicalIdentity := encodingtest.MustDecodeHex("eeffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff7f")
+	// a point of order 8
+	smallOrder := encodingtest.MustDecodeHex("c7176a703d4dd84fba3c0b760d10670f2a2053fa2c39ccc64ec7fd7792ac037a")
+	// S + L, the group order
+	nonCanonicalS := encodingtest.MustDecodeHex("edd3f55c1a631258d69cf7a2def9de1400000000000000000000000000000010")
+
+	tests := []struct {
+		name          string
+		pk            PublicKey
+		sig           []byte
+		want          bool
+		wantNonStrict bool
+	}{
+		{
+			"valid",
+			alicePublicKey,
+			sig,
+			true,
+			true,
+		},
+		{
+			"err-wrong-key",
+			bobPublicKey,
+			sig,
+			false,
+			false,
+		},
+		{
+			"err-identity-public-key",
+			PublicKey{Key: identity},
+			append(append([]byte{}, identity...), make([]byte, 32)...),
+			false,
+			true,
+		},
+		{
+			"err-non-canonical-identity-public-key",
+			PublicKey{Key: nonCanonicalIdentity},
+			append(append([]byte{}, identity...), make([]byte, 32)...),
+			false,
+			true,
+		},
+		{
+			"err-small-order-public-key",
+			PublicKey{Key: smallOrder},
+			sig,
+			false,
+			false,
+		},
+		{
+			"err-small-order-r",
+			alicePublicKey,
+			append(append([]byte{}, smallOrder...), sig[32:]...),
+			false,
+			false,
+		},
+		{
+			"err-non-canonical-s",
+			alicePublicKey,
+			append(append([]byte{}, sig[:32]...), nonCanonicalS...),
+			false,
+			false,
+		},
+		{
+			"err-short-signature",
+			alicePublicKey,
+			sig[:63],
+			false,
+			false,
+		},
+		{
+			"err-short-public-key",
+			PublicKey{Key: alicePublicKey.Key[:31]},
+			sig,
+			false,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.pk.VerifyStrict(message, tt.sig))
+			assert.Equal(t, tt.want, crypto.VerifyStrict(tt.pk, message, tt.sig))
+
+			if len(tt.pk.Key) == PublicKeySize {
+				assert.Equal(t, tt.wantNonStrict, tt.pk.Verify(message, tt.sig))
+			}
+		})
+	}
+}
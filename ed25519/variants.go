@@ -0,0 +1,179 @@
+package ed25519
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/sha512"
+	"errors"
+	"hash"
+	"io"
+
+	"filippo.io/edwards25519"
+	"golang.org/x/crypto/ed25519"
+)
+
+const (
+	// MaxContextSize is the maximum size, in bytes, of an Ed25519ctx or Ed25519ph context.
+	MaxContextSize = 255
+	// PrehashSize is the size, in bytes, of the SHA-512 digest of the message signed by Ed25519ph.
+	PrehashSize = sha512.Size
+
+	dom2Prefix = "SigEd25519 no Ed25519 collisions"
+)
+
+var (
+	// ErrInvalidContext is returned when the context is longer than 255 bytes, or empty for Ed25519ctx.
+	ErrInvalidContext = errors.New("ed25519: context must be at most 255 bytes and not empty for Ed25519ctx")
+	// ErrInvalidPrehash is returned when the prehash is not a 64 byte SHA-512 digest.
+	ErrInvalidPrehash = errors.New("ed25519: prehash must be a SHA-512 digest")
+)
+
+// SignWithContext signs the message with the context using Ed25519ctx as defined in RFC 8032.
+// The context must be between 1 and 255 bytes.
+func (pk PrivateKey) SignWithContext(context, message []byte) ([]byte, error) {
+	if len(context) == 0 || len(context) > MaxContextSize {
+		return nil, ErrInvalidContext
+	}
+
+	return pk.signDom2(dom2(0, context), message)
+}
+
+// SignPrehashed signs the SHA-512 digest of a message using Ed25519ph as defined in RFC 8032.
+// Ed25519ph is only defined over SHA-512, hashFunc must be crypto.SHA512 and digest the 64 byte SHA-512 of the
+// message, for example the Sum of NewPrehash that the message has been streamed into.
+// The context must be at most 255 bytes and can be empty.
+func (pk PrivateKey) SignPrehashed(context []byte, hashFunc crypto.Hash, digest []byte) ([]byte, error) {
+	if len(context) > MaxContextSize {
+		return nil, ErrInvalidContext
+	}
+
+	if hashFunc != crypto.SHA512 || len(digest) != PrehashSize {
+		return nil, ErrInvalidPrehash
+	}
+
+	return pk.signDom2(dom2(1, context), digest)
+}
+
+// SignReader streams the message from r and signs it using Ed25519ph, see SignPrehashed.
+func (pk PrivateKey) SignReader(context []byte, r io.Reader) ([]byte, error) {
+	digest := NewPrehash()
+	if _, err := io.Copy(digest, r); err != nil {
+		return nil, err
+	}
+
+	return pk.SignPrehashed(context, crypto.SHA512, digest.Sum(nil))
+}
+
+func (pk PrivateKey) signDom2(dom, message []byte) ([]byte, error) {
+	if len(pk.Key) != ed25519.PrivateKeySize {
+		return nil, errors.New("invalid key length")
+	}
+
+	h := sha512.Sum512(pk.Key.Seed())
+
+	s, err := edwards25519.NewScalar().SetBytesWithClamping(h[:32])
+	if err != nil {
+		return nil, err
+	}
+
+	rDigest := sha512.New()
+	rDigest.Write(dom)
+	rDigest.Write(h[32:])
+	rDigest.Write(message)
+
+	r, err := edwards25519.NewScalar().SetUniformBytes(rDigest.Sum(nil))
+	if err != nil {
+		return nil, err
+	}
+
+	R := new(edwards25519.Point).ScalarBaseMult(r)
+
+	k, err := challenge(dom, R.Bytes(), pk.Key[32:], message)
+	if err != nil {
+		return nil, err
+	}
+
+	S := edwards25519.NewScalar().MultiplyAdd(k, s, r)
+
+	return append(R.Bytes(), S.Bytes()...), nil
+}
+
+// VerifyWithContext verifies whether sig is a valid Ed25519ctx signature of the message with the context.
+func (pk PublicKey) VerifyWithContext(context, message, sig []byte) bool {
+	if len(context) == 0 || len(context) > MaxContextSize {
+		return false
+	}
+
+	return pk.verifyDom2(dom2(0, context), message, sig)
+}
+
+// VerifyPrehashed verifies whether sig is a valid Ed25519ph signature with the context of the message with the
+// SHA-512 digest, hashFunc must be crypto.SHA512 and digest 64 bytes, see SignPrehashed.
+func (pk PublicKey) VerifyPrehashed(context []byte, hashFunc crypto.Hash, digest, sig []byte) bool {
+	if len(context) > MaxContextSize || hashFunc != crypto.SHA512 || len(digest) != PrehashSize {
+		return false
+	}
+
+	return pk.verifyDom2(dom2(1, context), digest, sig)
+}
+
+// VerifyReader streams the message from r and verifies whether sig is a valid Ed25519ph signature of it, see VerifyPrehashed.
+func (pk PublicKey) VerifyReader(context []byte, r io.Reader, sig []byte) (bool, error) {
+	digest := NewPrehash()
+	if _, err := io.Copy(digest, r); err != nil {
+		return false, err
+	}
+
+	return pk.VerifyPrehashed(context, crypto.SHA512, digest.Sum(nil), sig), nil
+}
+
+func (pk PublicKey) verifyDom2(dom, message, sig []byte) bool {
+	if len(pk.Key) != PublicKeySize || len(sig) != SignatureSize {
+		return false
+	}
+
+	A, err := new(edwards25519.Point).SetBytes(pk.Key)
+	if err != nil {
+		return false
+	}
+
+	S, err := edwards25519.NewScalar().SetCanonicalBytes(sig[32:])
+	if err != nil {
+		return false
+	}
+
+	k, err := challenge(dom, sig[:32], pk.Key, message)
+	if err != nil {
+		return false
+	}
+
+	// R' = [S]B - [k]A, the signature is valid when R' encodes to R
+	minusA := new(edwards25519.Point).Negate(A)
+	R := new(edwards25519.Point).VarTimeDoubleScalarBaseMult(k, minusA, S)
+
+	return bytes.Equal(R.Bytes(), sig[:32])
+}
+
+// NewPrehash returns the SHA-512 hash that messages are streamed into, its Sum is the digest for SignPrehashed and VerifyPrehashed.
+func NewPrehash() hash.Hash {
+	return sha512.New()
+}
+
+// dom2 returns the RFC 8032 domain separation prefix for the Ed25519ctx and Ed25519ph variants.
+func dom2(phflag byte, context []byte) []byte {
+	out := make([]byte, 0, len(dom2Prefix)+2+len(context))
+	out = append(out, dom2Prefix...)
+	out = append(out, phflag, byte(len(context)))
+
+	return append(out, context...)
+}
+
+func challenge(dom, R, A, message []byte) (*edwards25519.Scalar, error) {
+	h := sha512.New()
+	h.Write(dom)
+	h.Write(R)
+	h.Write(A)
+	h.Write(message)
+
+	return edwards25519.NewScalar().SetUniformBytes(h.Sum(nil))
+}
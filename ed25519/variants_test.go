@@ -0,0 +1,170 @@
+package ed25519
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/sha256"
+	"crypto/sha512"
+	"errors"
+	"testing"
+	"testing/iotest"
+
+	"github.com/mailchain/go-encoding/encodingtest"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/sha3"
+)
+
+// https://www.rfc-editor.org/rfc/rfc8032#section-7.2
+func TestSignWithContext_RFC8032Vectors(t *testing.T) {
+	tests := []struct {
+		name      string
+		secretKey string
+		publicKey string
+		message   string
+		context   string
+		signature string
+	}{
+		{
+			"foo",
+			"0305334e381af78f141cb666f6199f57bc3495335a256a95bd2a55bf546663f6",
+			"dfc9425e4f968f7f0c29f0259cf5f9aed6851c2bb4ad8bfb860cfee0ab248292",
+			"f726936d19c800494e3fdaff20b276a8",
+			"666f6f",
+			"55a4cc2f70a54e04288c5f4cd1e45a7bb520b36292911876cada7323198dd87a8b36950b95130022907a7fb7c4e9b2d5f6cca685a587b4b21f4b888e4e7edb0d",
+		},
+		{
+			"bar",
+			"0305334e381af78f141cb666f6199f57bc3495335a256a95bd2a55bf546663f6",
+			"dfc9425e4f968f7f0c29f0259cf5f9aed6851c2bb4ad8bfb860cfee0ab248292",
+			"f726936d19c800494e3fdaff20b276a8",
+			"626172",
+			"fc60d5872fc46b3aa69f8b5b4351d5808f92bcc044606db097abab6dbcb1aee3216c48e8b3b66431b5b186d1d28f8ee15a5ca2df6668346291c2043d4eb3e90d",
+		},
+		{
+			"foo2",
+			"0305334e381af78f141cb666f6199f57bc3495335a256a95bd2a55bf546663f6",
+			"dfc9425e4f968f7f0c29f0259cf5f9aed6851c2bb4ad8bfb860cfee0ab248292",
+			"508e9e6882b979fea900f62adceaca35",
+			"666f6f",
+			"8b70c1cc8310e1de20ac53ce28ae6e7207f33c3295e03bb5c0732a1d20dc64908922a8b052cf99b7c4fe107a5abb5b2c4085ae75890d02df26269d8945f84b0b",
+		},
+		{
+			"foo3",
+			"ab9c2853ce297ddab85c993b3ae14bcad39b2c682beabc27d6d4eb20711d6560",
+			"0f1d1274943b91415889152e893d80e93275a1fc0b65fd71b4b0dda10ad7d772",
+			"f726936d19c800494e3fdaff20b276a8",
+			"666f6f",
+			"21655b5f1aa965996b3f97b3c849eafba922a0a62992f73b3d1b73106a84ad85e9b86a7b6005ea868337ff2d20a7f5fbd4cd10b0be49a68da2b2e0dc0ad8960f",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key, err := PrivateKeyFromBytes(encodingtest.MustDecodeHex(tt.secretKey))
+			if !assert.NoError(t, err) {
+				t.FailNow()
+			}
+
+			publicKey := key.PublicKey().(*PublicKey)
+			assert.Equal(t, encodingtest.MustDecodeHex(tt.publicKey), publicKey.Bytes())
+
+			message := encodingtest.MustDecodeHex(tt.message)
+			context := encodingtest.MustDecodeHex(tt.context)
+
+			got, err := key.SignWithContext(context, message)
+			assert.NoError(t, err)
+			assert.Equal(t, encodingtest.MustDecodeHex(tt.signature), got)
+			assert.True(t, publicKey.VerifyWithContext(context, message, got))
+			assert.False(t, publicKey.VerifyWithContext([]byte("baz"), message, got))
+			assert.False(t, publicKey.Verify(message, got))
+		})
+	}
+}
+
+// https://www.rfc-editor.org/rfc/rfc8032#section-7.3
+func TestSignPrehashed_RFC8032Vectors(t *testing.T) {
+	key, err := PrivateKeyFromBytes(encodingtest.MustDecodeHex("833fe62409237b9d62ec77587520911e9a759cec1d19755b7da901b96dca3d42"))
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	publicKey := key.PublicKey().(*PublicKey)
+	assert.Equal(t, encodingtest.MustDecodeHex("ec172b93ad5e563bf4932c70e1245034c35467ef2efd4d64ebf819683467e2bf"), publicKey.Bytes())
+
+	want := encodingtest.MustDecodeHex("98a70222f0b8121aa9d30f813d683f809e462b469c7ff87639499bb94e6dae4131f85042463c2a355a2003d062adf5aaa10b8c61e636062aaad11c2a26083406")
+
+	digest := NewPrehash()
+	digest.Write([]byte("abc"))
+
+	got, err := key.SignPrehashed(nil, crypto.SHA512, digest.Sum(nil))
+	assert.NoError(t, err)
+	assert.Equal(t, want, got)
+
+	got, err = key.SignReader(nil, iotest.OneByteReader(bytes.NewReader([]byte("abc"))))
+	assert.NoError(t, err)
+	assert.Equal(t, want, got)
+
+	verified, err := publicKey.VerifyReader(nil, bytes.NewReader([]byte("abc")), want)
+	assert.NoError(t, err)
+	assert.True(t, verified)
+
+	verified, err = publicKey.VerifyReader(nil, bytes.NewReader([]byte("abd")), want)
+	assert.NoError(t, err)
+	assert.False(t, verified)
+
+	assert.True(t, publicKey.VerifyPrehashed(nil, crypto.SHA512, digest.Sum(nil), want))
+	assert.False(t, publicKey.VerifyPrehashed([]byte("foo"), crypto.SHA512, digest.Sum(nil), want))
+	assert.False(t, publicKey.Verify([]byte("abc"), want))
+}
+
+func TestSignPrehashed(t *testing.T) {
+	context := []byte("attachment")
+
+	sig, err := bobPrivateKey.SignReader(context, bytes.NewReader(bytes.Repeat([]byte("large attachment "), 1024)))
+	assert.NoError(t, err)
+
+	verified, err := bobPublicKey.VerifyReader(context, bytes.NewReader(bytes.Repeat([]byte("large attachment "), 1024)), sig)
+	assert.NoError(t, err)
+	assert.True(t, verified)
+
+	verified, err = alicePublicKey.VerifyReader(context, bytes.NewReader(bytes.Repeat([]byte("large attachment "), 1024)), sig)
+	assert.NoError(t, err)
+	assert.False(t, verified)
+
+	digest := sha512.Sum512(bytes.Repeat([]byte("large attachment "), 1024))
+	assert.True(t, bobPublicKey.VerifyPrehashed(context, crypto.SHA512, digest[:], sig))
+
+	// Ed25519ph is only defined over SHA-512, other 64 byte digests are rejected.
+	sha3Digest := sha3.Sum512(bytes.Repeat([]byte("large attachment "), 1024))
+	_, err = bobPrivateKey.SignPrehashed(context, crypto.SHA3_512, sha3Digest[:])
+	assert.ErrorIs(t, err, ErrInvalidPrehash)
+	assert.False(t, bobPublicKey.VerifyPrehashed(context, crypto.SHA3_512, digest[:], sig))
+
+	sha256Digest := sha256.Sum256(bytes.Repeat([]byte("large attachment "), 1024))
+	_, err = bobPrivateKey.SignPrehashed(context, crypto.SHA512, sha256Digest[:])
+	assert.ErrorIs(t, err, ErrInvalidPrehash)
+	assert.False(t, bobPublicKey.VerifyPrehashed(context, crypto.SHA512, sha256Digest[:], sig))
+
+	_, err = bobPrivateKey.SignPrehashed(make([]byte, 256), crypto.SHA512, digest[:])
+	assert.ErrorIs(t, err, ErrInvalidContext)
+
+	readErr := errors.New("read failed")
+	_, err = bobPrivateKey.SignReader(context, iotest.ErrReader(readErr))
+	assert.ErrorIs(t, err, readErr)
+
+	_, err = bobPublicKey.VerifyReader(context, iotest.ErrReader(readErr), sig)
+	assert.ErrorIs(t, err, readErr)
+}
+
+func TestSignWithContext(t *testing.T) {
+	_, err := bobPrivateKey.SignWithContext(nil, []byte("message"))
+	assert.ErrorIs(t, err, ErrInvalidContext)
+
+	_, err = bobPrivateKey.SignWithContext(make([]byte, 256), []byte("message"))
+	assert.ErrorIs(t, err, ErrInvalidContext)
+
+	sig, err := bobPrivateKey.SignWithContext(make([]byte, 255), []byte("message"))
+	assert.NoError(t, err)
+	assert.True(t, bobPublicKey.VerifyWithContext(make([]byte, 255), []byte("message"), sig))
+	assert.False(t, bobPublicKey.VerifyWithContext(nil, []byte("message"), sig))
+	assert.False(t, bobPublicKey.VerifyWithContext(make([]byte, 255), []byte("message"), sig[:63]))
+}
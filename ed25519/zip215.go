@@ -0,0 +1,48 @@
+package ed25519
+
+import (
+	"filippo.io/edwards25519"
+)
+
+// VerifyZIP215 verifies whether sig is a valid signature of message using the validation rules of ZIP-215,
+// see https://zips.z.cash/zip-0215.
+// Unlike Verify, which follows golang.org/x/crypto/ed25519, the result is the same for every ZIP-215 implementation,
+// including for signatures with small order or non canonically encoded points:
+//   - the public key and R must be points on the curve, non canonical encodings are accepted and hashed as given,
+//   - S must be canonical, less than the group order,
+//   - the cofactored equation [8][S]B = [8]R + [8][k]A must hold.
+//
+// VerifyBatch uses the same rules, both for the batch and for the entries it verifies one at a time when the batch
+// fails, so a signature accepted by VerifyZIP215 is reported valid by VerifyBatch.
+func (pk PublicKey) VerifyZIP215(message, sig []byte) bool {
+	if len(pk.Key) != PublicKeySize || len(sig) != SignatureSize {
+		return false
+	}
+
+	A, err := new(edwards25519.Point).SetBytes(pk.Key)
+	if err != nil {
+		return false
+	}
+
+	R, err := new(edwards25519.Point).SetBytes(sig[:32])
+	if err != nil {
+		return false
+	}
+
+	S, err := edwards25519.NewScalar().SetCanonicalBytes(sig[32:])
+	if err != nil {
+		return false
+	}
+
+	k, err := challenge(nil, sig[:32], pk.Key, message)
+	if err != nil {
+		return false
+	}
+
+	// [8]([S]B - [k]A - R) = 0
+	minusA := new(edwards25519.Point).Negate(A)
+	check := new(edwards25519.Point).VarTimeDoubleScalarBaseMult(k, minusA, S)
+	check.Subtract(check, R)
+
+	return check.MultByCofactor(check).Equal(edwards25519.NewIdentityPoint()) == 1
+}
@@ -0,0 +1,266 @@
+// Package eip712 implements hashing and signing of EIP-712 typed structured data, see https://eips.ethereum.org/EIPS/eip-712.
+package eip712
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+)
+
+// DomainType is the name of the type that describes the domain.
+const DomainType = "EIP712Domain"
+
+var (
+	// ErrMissingDomainType is returned when the types do not include EIP712Domain.
+	ErrMissingDomainType = errors.New("eip712: types must include " + DomainType)
+	// ErrUnknownType is returned when a type is neither an atomic, dynamic or array type nor defined in the types.
+	ErrUnknownType = errors.New("eip712: unknown type")
+	// ErrInvalidValue is returned when a value can not be encoded as its type.
+	ErrInvalidValue = errors.New("eip712: invalid value")
+)
+
+// Field is a member of a struct type.
+type Field struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// Types are the struct types by their name.
+type Types map[string][]Field
+
+// TypedData is the EIP-712 typed structured data as sent to eth_signTypedData_v4.
+type TypedData struct {
+	Types       Types                  `json:"types"`
+	PrimaryType string                 `json:"primaryType"`
+	Domain      map[string]interface{} `json:"domain"`
+	Message     map[string]interface{} `json:"message"`
+}
+
+// Parse the JSON encoded typed data and check that all the types it uses are defined.
+// Numbers are decoded as json.Number so integers of up to 256 bits keep their precision.
+func Parse(in []byte) (*TypedData, error) {
+	decoder := json.NewDecoder(bytes.NewReader(in))
+	decoder.UseNumber()
+
+	td := &TypedData{}
+	if err := decoder.Decode(td); err != nil {
+		return nil, fmt.Errorf("eip712: %w", err)
+	}
+
+	if err := td.Validate(); err != nil {
+		return nil, err
+	}
+
+	return td, nil
+}
+
+// Validate checks that the domain type and primary type are defined and that every field has a known type.
+func (td *TypedData) Validate() error {
+	if _, ok := td.Types[DomainType]; !ok {
+		return ErrMissingDomainType
+	}
+
+	if _, ok := td.Types[td.PrimaryType]; !ok {
+		return fmt.Errorf("%w: primary type %q", ErrUnknownType, td.PrimaryType)
+	}
+
+	for name, fields := range td.Types {
+		for _, field := range fields {
+			fieldType := baseType(field.Type)
+			if _, ok := td.Types[fieldType]; ok {
+				continue
+			}
+
+			if !isAtomicType(fieldType) {
+				return fmt.Errorf("%w: %q of %s.%s", ErrUnknownType, field.Type, name, field.Name)
+			}
+		}
+	}
+
+	return nil
+}
+
+// EncodeType returns the encoding of the type followed by the encodings of the types it references sorted by name,
+// for example `Mail(Person from,Person to,string contents)Person(string name,address wallet)`.
+func (td *TypedData) EncodeType(name string) (string, error) {
+	if _, ok := td.Types[name]; !ok {
+		return "", fmt.Errorf("%w: %q", ErrUnknownType, name)
+	}
+
+	deps := map[string]bool{}
+	td.dependencies(name, deps)
+	delete(deps, name)
+
+	names := make([]string, 0, len(deps))
+	for dep := range deps {
+		names = append(names, dep)
+	}
+
+	sort.Strings(names)
+
+	var sb strings.Builder
+
+	for _, typeName := range append([]string{name}, names...) {
+		fields := td.Types[typeName]
+		params := make([]string, len(fields))
+
+		for i, field := range fields {
+			params[i] = field.Type + " " + field.Name
+		}
+
+		sb.WriteString(typeName + "(" + strings.Join(params, ",") + ")")
+	}
+
+	return sb.String(), nil
+}
+
+func (td *TypedData) dependencies(name string, found map[string]bool) {
+	if found[name] {
+		return
+	}
+
+	if _, ok := td.Types[name]; !ok {
+		return
+	}
+
+	found[name] = true
+
+	for _, field := range td.Types[name] {
+		td.dependencies(baseType(field.Type), found)
+	}
+}
+
+// TypeHash returns keccak256(EncodeType(name)).
+func (td *TypedData) TypeHash(name string) ([]byte, error) {
+	encoded, err := td.EncodeType(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return ethcrypto.Keccak256([]byte(encoded)), nil
+}
+
+// HashStruct returns keccak256(TypeHash(name) || encodeData(data)) of the data as the struct type name.
+func (td *TypedData) HashStruct(name string, data map[string]interface{}) ([]byte, error) {
+	encoded, err := td.encodeData(name, data)
+	if err != nil {
+		return nil, err
+	}
+
+	return ethcrypto.Keccak256(encoded), nil
+}
+
+// DomainSeparator returns HashStruct of the domain.
+func (td *TypedData) DomainSeparator() ([]byte, error) {
+	return td.HashStruct(DomainType, td.Domain)
+}
+
+// Hash returns the digest that is signed, keccak256(0x19 || 0x01 || DomainSeparator || HashStruct(message)).
+// When the primary type is EIP712Domain the message hash is omitted.
+func (td *TypedData) Hash() ([]byte, error) {
+	domainSeparator, err := td.DomainSeparator()
+	if err != nil {
+		return nil, err
+	}
+
+	if td.PrimaryType == DomainType {
+		return ethcrypto.Keccak256([]byte{0x19, 0x01}, domainSeparator), nil
+	}
+
+	messageHash, err := td.HashStruct(td.PrimaryType, td.Message)
+	if err != nil {
+		return nil, err
+	}
+
+	return ethcrypto.Keccak256([]byte{0x19, 0x01}, domainSeparator, messageHash), nil
+}
+
+func (td *TypedData) encodeData(name string, data map[string]interface{}) ([]byte, error) {
+	fields, ok := td.Types[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownType, name)
+	}
+
+	for key := range data {
+		if !hasField(fields, key) {
+			return nil, fmt.Errorf("%w: %s has no field %q", ErrInvalidValue, name, key)
+		}
+	}
+
+	typeHash, err := td.TypeHash(name)
+	if err != nil {
+		return nil, err
+	}
+
+	encoded := make([]byte, 0, 32*(len(fields)+1))
+	encoded = append(encoded, typeHash...)
+
+	for _, field := range fields {
+		value, ok := data[field.Name]
+		if !ok {
+			return nil, fmt.Errorf("%w: missing %s.%s", ErrInvalidValue, name, field.Name)
+		}
+
+		fieldEncoded, err := td.encodeValue(field.Type, value)
+		if err != nil {
+			return nil, fmt.Errorf("%s.%s: %w", name, field.Name, err)
+		}
+
+		encoded = append(encoded, fieldEncoded...)
+	}
+
+	return encoded, nil
+}
+
+// encodeValue returns the 32 byte encoding of the value as fieldType.
+func (td *TypedData) encodeValue(fieldType string, value interface{}) ([]byte, error) {
+	if elemType, length, ok := arrayType(fieldType); ok {
+		items, ok := value.([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("%w: %s must be an array", ErrInvalidValue, fieldType)
+		}
+
+		if length >= 0 && len(items) != length {
+			return nil, fmt.Errorf("%w: %s must have %d items", ErrInvalidValue, fieldType, length)
+		}
+
+		encoded := make([]byte, 0, 32*len(items))
+
+		for _, item := range items {
+			itemEncoded, err := td.encodeValue(elemType, item)
+			if err != nil {
+				return nil, err
+			}
+
+			encoded = append(encoded, itemEncoded...)
+		}
+
+		return ethcrypto.Keccak256(encoded), nil
+	}
+
+	if _, ok := td.Types[fieldType]; ok {
+		data, ok := value.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("%w: %s must be an object", ErrInvalidValue, fieldType)
+		}
+
+		return td.HashStruct(fieldType, data)
+	}
+
+	return encodeAtomic(fieldType, value)
+}
+
+func hasField(fields []Field, name string) bool {
+	for _, field := range fields {
+		if field.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,273 @@
+package eip712
+
+import (
+	"encoding/json"
+	"testing"
+
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/mailchain/go-encoding/encodingtest"
+	"github.com/stretchr/testify/assert"
+)
+
+// https://github.com/ethereum/EIPs/blob/master/assets/eip-712/Example.js
+const mailJSON = `{
+	"types": {
+		"EIP712Domain": [
+			{"name": "name", "type": "string"},
+			{"name": "version", "type": "string"},
+			{"name": "chainId", "type": "uint256"},
+			{"name": "verifyingContract", "type": "address"}
+		],
+		"Person": [
+			{"name": "name", "type": "string"},
+			{"name": "wallet", "type": "address"}
+		],
+		"Mail": [
+			{"name": "from", "type": "Person"},
+			{"name": "to", "type": "Person"},
+			{"name": "contents", "type": "string"}
+		]
+	},
+	"primaryType": "Mail",
+	"domain": {
+		"name": "Ether Mail",
+		"version": "1",
+		"chainId": 1,
+		"verifyingContract": "0xCcCCccccCCCCcCCCCCCcCcCccCcCCCcCcccccccC"
+	},
+	"message": {
+		"from": {"name": "Cow", "wallet": "0xCD2a3d9F938E13CD947Ec05AbC7FE734Df8DD826"},
+		"to": {"name": "Bob", "wallet": "0xbBbBBBBbbBBBbbbBbbBbbbbBBbBbbbbBbBbbBBbB"},
+		"contents": "Hello, Bob!"
+	}
+}`
+
+// nestedJSON uses arrays of structs, arrays of atomic types and the dynamic types.
+const nestedJSON = `{
+	"types": {
+		"EIP712Domain": [
+			{"name": "name", "type": "string"},
+			{"name": "chainId", "type": "uint256"}
+		],
+		"Person": [
+			{"name": "name", "type": "string"},
+			{"name": "wallets", "type": "address[]"}
+		],
+		"Group": [
+			{"name": "name", "type": "string"},
+			{"name": "members", "type": "Person[]"},
+			{"name": "scores", "type": "int32[]"},
+			{"name": "data", "type": "bytes"},
+			{"name": "tag", "type": "bytes4"},
+			{"name": "active", "type": "bool"}
+		]
+	},
+	"primaryType": "Group",
+	"domain": {"name": "Groups", "chainId": "0x89"},
+	"message": {
+		"name": "Family",
+		"members": [
+			{"name": "Alice", "wallets": ["0xCD2a3d9F938E13CD947Ec05AbC7FE734Df8DD826", "0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF"]},
+			{"name": "Bob", "wallets": []}
+		],
+		"scores": [-5, 1000],
+		"data": "0x0102030405",
+		"tag": "0xdeadbeef",
+		"active": true
+	}
+}`
+
+func mustParse(t *testing.T, in string) *TypedData {
+	td, err := Parse([]byte(in))
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	return td
+}
+
+func TestTypedData_Mail(t *testing.T) {
+	td := mustParse(t, mailJSON)
+
+	encodedType, err := td.EncodeType("Mail")
+	assert.NoError(t, err)
+	assert.Equal(t, "Mail(Person from,Person to,string contents)Person(string name,address wallet)", encodedType)
+
+	typeHash, err := td.TypeHash("Mail")
+	assert.NoError(t, err)
+	assert.Equal(t, encodingtest.MustDecodeHex("a0cedeb2dc280ba39b857546d74f5549c3a1d7bdc2dd96bf881f76108e23dac2"), typeHash)
+
+	messageHash, err := td.HashStruct("Mail", td.Message)
+	assert.NoError(t, err)
+	assert.Equal(t, encodingtest.MustDecodeHex("c52c0ee5d84264471806290a3f2c4cecfc5490626bf912d01f240d7a274b371e"), messageHash)
+
+	domainSeparator, err := td.DomainSeparator()
+	assert.NoError(t, err)
+	assert.Equal(t, encodingtest.MustDecodeHex("f2cee375fa42b42143804025fc449deafd50cc031ca257e0b194a650a912090f"), domainSeparator)
+
+	hash, err := td.Hash()
+	assert.NoError(t, err)
+	assert.Equal(t, encodingtest.MustDecodeHex("be609aee343fb3c4b28e1df9e632fca64fcfaede20f02e86244efddf30957bd2"), hash)
+}
+
+func TestTypedData_Nested(t *testing.T) {
+	td := mustParse(t, nestedJSON)
+
+	encodedType, err := td.EncodeType("Group")
+	assert.NoError(t, err)
+	assert.Equal(t, "Group(string name,Person[] members,int32[] scores,bytes data,bytes4 tag,bool active)Person(string name,address[] wallets)", encodedType)
+
+	got, err := td.Hash()
+	assert.NoError(t, err)
+
+	want := apitypes.TypedData{}
+	if !assert.NoError(t, json.Unmarshal([]byte(nestedJSON), &want)) {
+		t.FailNow()
+	}
+
+	wantHash, _, err := apitypes.TypedDataAndHash(want)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	assert.Equal(t, wantHash, got)
+}
+
+func TestTypedData_Arrays(t *testing.T) {
+	td := mustParse(t, `{
+		"types": {
+			"EIP712Domain": [],
+			"Scores": [
+				{"name": "scores", "type": "int32[2]"},
+				{"name": "matrix", "type": "uint8[][]"}
+			]
+		},
+		"primaryType": "Scores",
+		"domain": {},
+		"message": {"scores": [-5, 1000], "matrix": [[1, 2], [3]]}
+	}`)
+
+	got, err := td.HashStruct("Scores", td.Message)
+	assert.NoError(t, err)
+
+	word := func(n byte) []byte {
+		out := make([]byte, 32)
+		out[31] = n
+
+		return out
+	}
+
+	typeHash := ethcrypto.Keccak256([]byte("Scores(int32[2] scores,uint8[][] matrix)"))
+	scores := ethcrypto.Keccak256(
+		encodingtest.MustDecodeHex("fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffb"),
+		encodingtest.MustDecodeHex("00000000000000000000000000000000000000000000000000000000000003e8"),
+	)
+	matrix := ethcrypto.Keccak256(ethcrypto.Keccak256(word(1), word(2)), ethcrypto.Keccak256(word(3)))
+	assert.Equal(t, ethcrypto.Keccak256(typeHash, scores, matrix), got)
+
+	td.Message["scores"] = []interface{}{json.Number("1")}
+	_, err = td.Hash()
+	assert.ErrorIs(t, err, ErrInvalidValue)
+}
+
+func TestTypedData_DomainPrimaryType(t *testing.T) {
+	td := mustParse(t, mailJSON)
+	td.PrimaryType = DomainType
+	td.Message = nil
+
+	domainSeparator, err := td.DomainSeparator()
+	assert.NoError(t, err)
+
+	got, err := td.Hash()
+	assert.NoError(t, err)
+	assert.Equal(t, ethcrypto.Keccak256([]byte{0x19, 0x01}, domainSeparator), got)
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      string
+		wantErr error
+	}{
+		{
+			"missing-domain",
+			`{"types": {"Mail": [{"name": "contents", "type": "string"}]}, "primaryType": "Mail"}`,
+			ErrMissingDomainType,
+		},
+		{
+			"unknown-primary-type",
+			`{"types": {"EIP712Domain": [], "Mail": []}, "primaryType": "Letter"}`,
+			ErrUnknownType,
+		},
+		{
+			"unknown-field-type",
+			`{"types": {"EIP712Domain": [], "Mail": [{"name": "from", "type": "Persn"}]}, "primaryType": "Mail"}`,
+			ErrUnknownType,
+		},
+		{
+			"invalid-integer-size",
+			`{"types": {"EIP712Domain": [], "Mail": [{"name": "n", "type": "uint7"}]}, "primaryType": "Mail"}`,
+			ErrUnknownType,
+		},
+		{
+			"invalid-bytes-size",
+			`{"types": {"EIP712Domain": [], "Mail": [{"name": "b", "type": "bytes33"}]}, "primaryType": "Mail"}`,
+			ErrUnknownType,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse([]byte(tt.in))
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+
+	_, err := Parse([]byte(`{"types": `))
+	assert.Error(t, err)
+}
+
+func TestTypedData_HashInvalidValue(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(message map[string]interface{})
+	}{
+		{
+			"missing-field",
+			func(message map[string]interface{}) { delete(message, "contents") },
+		},
+		{
+			"extra-field",
+			func(message map[string]interface{}) { message["subject"] = "Hello" },
+		},
+		{
+			"short-address",
+			func(message map[string]interface{}) {
+				message["from"].(map[string]interface{})["wallet"] = "0xCD2a3d9F938E13CD947Ec05AbC7FE734Df8DD8"
+			},
+		},
+		{
+			"address-without-prefix",
+			func(message map[string]interface{}) {
+				message["from"].(map[string]interface{})["wallet"] = "CD2a3d9F938E13CD947Ec05AbC7FE734Df8DD826"
+			},
+		},
+		{
+			"struct-not-object",
+			func(message map[string]interface{}) { message["to"] = "Bob" },
+		},
+		{
+			"string-not-string",
+			func(message map[string]interface{}) { message["contents"] = json.Number("1") },
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			td := mustParse(t, mailJSON)
+			tt.modify(td.Message)
+
+			_, err := td.Hash()
+			assert.ErrorIs(t, err, ErrInvalidValue)
+		})
+	}
+}
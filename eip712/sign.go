@@ -0,0 +1,39 @@
+package eip712
+
+import (
+	"bytes"
+
+	"github.com/mailchain/go-crypto/secp256k1"
+)
+
+// Sign the typed data with the private key, the returned signature has a V of 27 or 28 the same as signatures
+// produced by wallets.
+func Sign(key secp256k1.PrivateKey, td *TypedData) ([]byte, error) {
+	hash, err := td.Hash()
+	if err != nil {
+		return nil, err
+	}
+
+	return key.SignEthereumHash(hash)
+}
+
+// Verify that the signature of the typed data was created by the public key.
+// Signatures with a V of 0, 1, 27 or 28 are accepted.
+func Verify(key secp256k1.PublicKey, td *TypedData, sig []byte) bool {
+	recovered, err := Recover(td, sig)
+	if err != nil {
+		return false
+	}
+
+	return bytes.Equal(recovered.Bytes(), key.Bytes())
+}
+
+// Recover the public key that created the signature of the typed data.
+func Recover(td *TypedData, sig []byte) (*secp256k1.PublicKey, error) {
+	hash, err := td.Hash()
+	if err != nil {
+		return nil, err
+	}
+
+	return secp256k1.RecoverPublicKey(hash, sig)
+}
@@ -0,0 +1,44 @@
+package eip712
+
+import (
+	"testing"
+
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/mailchain/go-crypto/secp256k1"
+	"github.com/mailchain/go-encoding/encodingtest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSignVerify(t *testing.T) {
+	// the private key of the `Cow` wallet in https://github.com/ethereum/EIPs/blob/master/assets/eip-712/Example.js
+	key, err := secp256k1.PrivateKeyFromBytes(ethcrypto.Keccak256([]byte("cow")))
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	publicKey := key.PublicKey().(*secp256k1.PublicKey)
+	assert.Equal(t, "0xCD2a3d9F938E13CD947Ec05AbC7FE734Df8DD826", ethcrypto.PubkeyToAddress(*publicKey.ECDSA()).Hex())
+
+	td := mustParse(t, mailJSON)
+	want := encodingtest.MustDecodeHex("4355c47d63924e8a72e509b65029052eb6c299d53a04e167c5775fd466751c9d07299936d304c153f6443dfa05f40ff007d72911b6f72307f996231605b915621c")
+
+	got, err := Sign(*key, td)
+	assert.NoError(t, err)
+	assert.Equal(t, want, got)
+	assert.True(t, Verify(*publicKey, td, got))
+
+	recovered, err := Recover(td, got)
+	assert.NoError(t, err)
+	assert.Equal(t, publicKey.Bytes(), recovered.Bytes())
+
+	other := mustParse(t, mailJSON)
+	other.Message["contents"] = "Hello, Alice!"
+	assert.False(t, Verify(*publicKey, other, got))
+
+	invalid := mustParse(t, mailJSON)
+	delete(invalid.Message, "contents")
+	assert.False(t, Verify(*publicKey, invalid, got))
+
+	_, err = Sign(*key, invalid)
+	assert.ErrorIs(t, err, ErrInvalidValue)
+}
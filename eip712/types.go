@@ -0,0 +1,260 @@
+package eip712
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+)
+
+const addressSize = 20
+
+var twoTo256 = new(big.Int).Lsh(big.NewInt(1), 256) //nolint: gochecknoglobals
+
+// arrayType returns the element type and length of an array type, the length is -1 for dynamic arrays.
+func arrayType(t string) (elemType string, length int, ok bool) {
+	if !strings.HasSuffix(t, "]") {
+		return "", 0, false
+	}
+
+	i := strings.LastIndex(t, "[")
+	if i <= 0 {
+		return "", 0, false
+	}
+
+	size := t[i+1 : len(t)-1]
+	if size == "" {
+		return t[:i], -1, true
+	}
+
+	length, err := strconv.Atoi(size)
+	if err != nil || length <= 0 || size[0] == '0' {
+		return "", 0, false
+	}
+
+	return t[:i], length, true
+}
+
+// baseType returns the type without any array suffixes.
+func baseType(t string) string {
+	for {
+		elemType, _, ok := arrayType(t)
+		if !ok {
+			return t
+		}
+
+		t = elemType
+	}
+}
+
+func isAtomicType(t string) bool {
+	switch t {
+	case "bool", "address", "string", "bytes":
+		return true
+	}
+
+	_, ok := bytesSize(t)
+	if ok {
+		return true
+	}
+
+	_, _, ok = integerSize(t)
+
+	return ok
+}
+
+// bytesSize returns N of a bytesN type.
+func bytesSize(t string) (int, bool) {
+	if !strings.HasPrefix(t, "bytes") || t == "bytes" {
+		return 0, false
+	}
+
+	size, err := strconv.Atoi(t[len("bytes"):])
+	if err != nil || size < 1 || size > 32 || strconv.Itoa(size) != t[len("bytes"):] {
+		return 0, false
+	}
+
+	return size, true
+}
+
+// integerSize returns the number of bits of an intN or uintN type.
+func integerSize(t string) (bits int, signed bool, ok bool) {
+	var suffix string
+
+	switch {
+	case strings.HasPrefix(t, "uint"):
+		suffix = t[len("uint"):]
+	case strings.HasPrefix(t, "int"):
+		suffix, signed = t[len("int"):], true
+	default:
+		return 0, false, false
+	}
+
+	bits, err := strconv.Atoi(suffix)
+	if err != nil || bits < 8 || bits > 256 || bits%8 != 0 || strconv.Itoa(bits) != suffix {
+		return 0, false, false
+	}
+
+	return bits, signed, true
+}
+
+// encodeAtomic returns the 32 byte encoding of an atomic or dynamic value.
+func encodeAtomic(t string, value interface{}) ([]byte, error) {
+	switch t {
+	case "string":
+		s, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("%w: string must be a string", ErrInvalidValue)
+		}
+
+		return ethcrypto.Keccak256([]byte(s)), nil
+	case "bytes":
+		b, err := toBytes(value)
+		if err != nil {
+			return nil, err
+		}
+
+		return ethcrypto.Keccak256(b), nil
+	case "bool":
+		b, ok := value.(bool)
+		if !ok {
+			return nil, fmt.Errorf("%w: bool must be a boolean", ErrInvalidValue)
+		}
+
+		out := make([]byte, 32)
+		if b {
+			out[31] = 1
+		}
+
+		return out, nil
+	case "address":
+		b, err := toBytes(value)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(b) != addressSize {
+			return nil, fmt.Errorf("%w: address must be 20 bytes", ErrInvalidValue)
+		}
+
+		return append(make([]byte, 32-addressSize), b...), nil
+	}
+
+	if size, ok := bytesSize(t); ok {
+		b, err := toBytes(value)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(b) != size {
+			return nil, fmt.Errorf("%w: %s must be %d bytes", ErrInvalidValue, t, size)
+		}
+
+		out := make([]byte, 32)
+		copy(out, b)
+
+		return out, nil
+	}
+
+	if bits, signed, ok := integerSize(t); ok {
+		return encodeInteger(t, bits, signed, value)
+	}
+
+	return nil, fmt.Errorf("%w: %q", ErrUnknownType, t)
+}
+
+func encodeInteger(t string, bits int, signed bool, value interface{}) ([]byte, error) {
+	n, err := toBigInt(value)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := new(big.Int).Lsh(big.NewInt(1), uint(bits))
+	if signed {
+		limit.Rsh(limit, 1)
+	}
+
+	if n.Cmp(limit) >= 0 || (signed && n.Cmp(new(big.Int).Neg(limit)) < 0) || (!signed && n.Sign() < 0) {
+		return nil, fmt.Errorf("%w: %s out of range for %s", ErrInvalidValue, n, t)
+	}
+
+	if n.Sign() < 0 {
+		n = new(big.Int).Add(n, twoTo256)
+	}
+
+	return n.FillBytes(make([]byte, 32)), nil
+}
+
+// toBigInt converts a number, a decimal string or a 0x prefixed hex string to an integer.
+func toBigInt(value interface{}) (*big.Int, error) {
+	var s string
+
+	switch v := value.(type) {
+	case *big.Int:
+		return v, nil
+	case json.Number:
+		s = v.String()
+	case string:
+		s = v
+	case float64:
+		n, accuracy := big.NewFloat(v).Int(nil)
+		if accuracy != big.Exact {
+			return nil, fmt.Errorf("%w: %v is not an integer", ErrInvalidValue, v)
+		}
+
+		return n, nil
+	case int:
+		return big.NewInt(int64(v)), nil
+	case int64:
+		return big.NewInt(v), nil
+	case uint64:
+		return new(big.Int).SetUint64(v), nil
+	default:
+		return nil, fmt.Errorf("%w: %T is not an integer", ErrInvalidValue, value)
+	}
+
+	n, ok := new(big.Int), false
+
+	switch {
+	case strings.HasPrefix(s, "0x") || strings.HasPrefix(s, "0X"):
+		n, ok = n.SetString(s[2:], 16)
+	case strings.HasPrefix(s, "-0x") || strings.HasPrefix(s, "-0X"):
+		n, ok = n.SetString(s[3:], 16)
+		if ok {
+			n.Neg(n)
+		}
+	default:
+		n, ok = n.SetString(s, 10)
+	}
+
+	if !ok {
+		return nil, fmt.Errorf("%w: %q is not an integer", ErrInvalidValue, s)
+	}
+
+	return n, nil
+}
+
+// toBytes converts a 0x prefixed hex string to bytes.
+func toBytes(value interface{}) ([]byte, error) {
+	switch v := value.(type) {
+	case []byte:
+		return v, nil
+	case string:
+		if !strings.HasPrefix(v, "0x") && !strings.HasPrefix(v, "0X") {
+			return nil, fmt.Errorf("%w: %q must be 0x prefixed hex", ErrInvalidValue, v)
+		}
+
+		b, err := hex.DecodeString(v[2:])
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidValue, err)
+		}
+
+		return b, nil
+	default:
+		return nil, fmt.Errorf("%w: %T is not bytes", ErrInvalidValue, value)
+	}
+}
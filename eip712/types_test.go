@@ -0,0 +1,144 @@
+package eip712
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/mailchain/go-encoding/encodingtest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeAtomic(t *testing.T) {
+	tests := []struct {
+		name    string
+		t       string
+		value   interface{}
+		want    []byte
+		wantErr error
+	}{
+		{
+			"uint256-json-number",
+			"uint256",
+			json.Number("1"),
+			encodingtest.MustDecodeHex("0000000000000000000000000000000000000000000000000000000000000001"),
+			nil,
+		},
+		{
+			"uint256-hex-string",
+			"uint256",
+			"0xff",
+			encodingtest.MustDecodeHex("00000000000000000000000000000000000000000000000000000000000000ff"),
+			nil,
+		},
+		{
+			"uint256-max",
+			"uint256",
+			"115792089237316195423570985008687907853269984665640564039457584007913129639935",
+			encodingtest.MustDecodeHex("ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff"),
+			nil,
+		},
+		{
+			"int8-negative",
+			"int8",
+			json.Number("-128"),
+			encodingtest.MustDecodeHex("ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff80"),
+			nil,
+		},
+		{
+			"int64-big-int",
+			"int64",
+			big.NewInt(-1),
+			encodingtest.MustDecodeHex("ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff"),
+			nil,
+		},
+		{
+			"bytes4",
+			"bytes4",
+			"0xdeadbeef",
+			encodingtest.MustDecodeHex("deadbeef00000000000000000000000000000000000000000000000000000000"),
+			nil,
+		},
+		{
+			"bool-false",
+			"bool",
+			false,
+			make([]byte, 32),
+			nil,
+		},
+		{
+			"err-uint8-overflow",
+			"uint8",
+			json.Number("256"),
+			nil,
+			ErrInvalidValue,
+		},
+		{
+			"err-int8-underflow",
+			"int8",
+			json.Number("-129"),
+			nil,
+			ErrInvalidValue,
+		},
+		{
+			"err-uint-negative",
+			"uint256",
+			json.Number("-1"),
+			nil,
+			ErrInvalidValue,
+		},
+		{
+			"err-uint-fraction",
+			"uint256",
+			1.5,
+			nil,
+			ErrInvalidValue,
+		},
+		{
+			"err-bytes4-length",
+			"bytes4",
+			"0xdeadbe",
+			nil,
+			ErrInvalidValue,
+		},
+		{
+			"err-bool-string",
+			"bool",
+			"true",
+			nil,
+			ErrInvalidValue,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := encodeAtomic(tt.t, tt.value)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestArrayType(t *testing.T) {
+	tests := []struct {
+		in         string
+		wantElem   string
+		wantLength int
+		wantOK     bool
+	}{
+		{"uint8[]", "uint8", -1, true},
+		{"Person[3]", "Person", 3, true},
+		{"uint8[][2]", "uint8[]", 2, true},
+		{"uint8", "", 0, false},
+		{"uint8[0]", "", 0, false},
+		{"uint8[02]", "", 0, false},
+		{"[]", "", 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			gotElem, gotLength, gotOK := arrayType(tt.in)
+			assert.Equal(t, tt.wantElem, gotElem)
+			assert.Equal(t, tt.wantLength, gotLength)
+			assert.Equal(t, tt.wantOK, gotOK)
+		})
+	}
+}
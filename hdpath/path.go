@@ -0,0 +1,105 @@
+// Package hdpath parses and formats BIP-32 derivation paths such as `m/44'/60'/0'/0/0`.
+package hdpath
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/mailchain/go-crypto/internal/hdkey"
+)
+
+// HardenedKeyStart is the index at which hardened keys begin, hardened indexes are written with a `'` suffix.
+const HardenedKeyStart = hdkey.HardenedKeyStart
+
+// ErrInvalidPath is returned when a derivation path can not be parsed.
+var ErrInvalidPath = errors.New("invalid derivation path")
+
+// Path is a BIP-32 derivation path, each element is the child index to derive from the previous key.
+// Hardened indexes include HardenedKeyStart.
+type Path []uint32
+
+// Parse a derivation path in the form `m/44'/60'/0'/0/0`.
+// Hardened indexes are marked with `'`, `h` or `H`, the path must start with `m`.
+func Parse(in string) (Path, error) {
+	segments := strings.Split(strings.TrimSpace(in), "/")
+	if segments[0] != "m" {
+		return nil, ErrInvalidPath
+	}
+
+	path := make(Path, 0, len(segments)-1)
+
+	for _, segment := range segments[1:] {
+		index, err := parseIndex(segment)
+		if err != nil {
+			return nil, err
+		}
+
+		path = append(path, index)
+	}
+
+	return path, nil
+}
+
+func parseIndex(segment string) (uint32, error) {
+	hardened := false
+
+	if strings.HasSuffix(segment, "'") || strings.HasSuffix(segment, "h") || strings.HasSuffix(segment, "H") {
+		hardened = true
+		segment = segment[:len(segment)-1]
+	}
+
+	// only plain decimal numbers are accepted, no signs, spaces or leading zeros.
+	if segment == "" || segment[0] < '0' || segment[0] > '9' || (len(segment) > 1 && segment[0] == '0') {
+		return 0, ErrInvalidPath
+	}
+
+	index, err := strconv.ParseUint(segment, 10, 32)
+	if err != nil || uint32(index) >= HardenedKeyStart {
+		return 0, ErrInvalidPath
+	}
+
+	if hardened {
+		return uint32(index) + HardenedKeyStart, nil
+	}
+
+	return uint32(index), nil
+}
+
+// BIP44 returns the path `m/44'/coinType'/account'/change/index` as defined in BIP-44.
+func BIP44(coinType, account, change, index uint32) Path {
+	return Path{44 + HardenedKeyStart, coinType + HardenedKeyStart, account + HardenedKeyStart, change, index}
+}
+
+// String returns the path in the form `m/44'/60'/0'/0/0`.
+func (p Path) String() string {
+	var sb strings.Builder
+
+	sb.WriteString("m")
+
+	for _, index := range p {
+		sb.WriteString("/")
+
+		if index >= HardenedKeyStart {
+			sb.WriteString(strconv.FormatUint(uint64(index-HardenedKeyStart), 10))
+			sb.WriteString("'")
+
+			continue
+		}
+
+		sb.WriteString(strconv.FormatUint(uint64(index), 10))
+	}
+
+	return sb.String()
+}
+
+// IsHardened returns true when every index in the path is hardened.
+func (p Path) IsHardened() bool {
+	for _, index := range p {
+		if index < HardenedKeyStart {
+			return false
+		}
+	}
+
+	return true
+}
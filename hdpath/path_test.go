@@ -0,0 +1,64 @@
+package hdpath
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      string
+		want    Path
+		wantErr error
+	}{
+		{"master", "m", Path{}, nil},
+		{"bip44-ethereum", "m/44'/60'/0'/0/0", Path{0x8000002c, 0x8000003c, 0x80000000, 0, 0}, nil},
+		{"hardened-h", "m/0h/1H/2", Path{0x80000000, 0x80000001, 2}, nil},
+		{"max-index", "m/2147483647'/2147483647", Path{0xffffffff, 0x7fffffff}, nil},
+		{"err-empty", "", nil, ErrInvalidPath},
+		{"err-no-master", "44'/60'", nil, ErrInvalidPath},
+		{"err-trailing-slash", "m/44'/", nil, ErrInvalidPath},
+		{"err-double-hardened", "m/44''", nil, ErrInvalidPath},
+		{"err-index-too-large", "m/2147483648", nil, ErrInvalidPath},
+		{"err-negative", "m/-1", nil, ErrInvalidPath},
+		{"err-plus", "m/+1", nil, ErrInvalidPath},
+		{"err-leading-zero", "m/01", nil, ErrInvalidPath},
+		{"err-not-number", "m/a", nil, ErrInvalidPath},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(tt.in)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestPath_String(t *testing.T) {
+	tests := []struct {
+		name string
+		path Path
+		want string
+	}{
+		{"master", Path{}, "m"},
+		{"bip44", BIP44(60, 0, 0, 0), "m/44'/60'/0'/0/0"},
+		{"mixed", Path{0x80000000, 1, 0xffffffff}, "m/0'/1/2147483647'"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.path.String())
+
+			parsed, err := Parse(tt.want)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.path, parsed)
+		})
+	}
+}
+
+func TestPath_IsHardened(t *testing.T) {
+	assert.True(t, Path{}.IsHardened())
+	assert.True(t, Path{0x80000000, 0x80000001}.IsHardened())
+	assert.False(t, BIP44(60, 0, 0, 0).IsHardened())
+}
@@ -0,0 +1,112 @@
+// Package ecdsasig converts ECDSA signatures between the ASN.1 DER encoding and the fixed width r || s encoding,
+// also known as IEEE P1363, shared by the NIST P-256 and secp256k1 implementations.
+package ecdsasig
+
+import (
+	"errors"
+	"math/big"
+
+	"golang.org/x/crypto/cryptobyte"
+	"golang.org/x/crypto/cryptobyte/asn1"
+)
+
+var (
+	// ErrInvalidDER is returned when a signature is not a strict DER encoded ECDSA-Sig-Value.
+	ErrInvalidDER = errors.New("signature is not strict DER")
+	// ErrInvalidFixed is returned when a fixed width signature is not twice the size of the curve order.
+	ErrInvalidFixed = errors.New("fixed width signature has an invalid length")
+	// ErrOutOfRange is returned when r or s is not between 1 and the curve order - 1.
+	ErrOutOfRange = errors.New("signature values must be between 1 and the curve order")
+)
+
+// ParseDER parses the strict DER encoding of ECDSA-Sig-Value ::= SEQUENCE { r INTEGER, s INTEGER }.
+// Non minimal lengths and integers, negative values and trailing data are rejected.
+func ParseDER(der []byte) (r, s *big.Int, err error) {
+	var inner cryptobyte.String
+
+	input := cryptobyte.String(der)
+	r, s = new(big.Int), new(big.Int)
+
+	if !input.ReadASN1(&inner, asn1.SEQUENCE) || !input.Empty() ||
+		!inner.ReadASN1Integer(r) || !inner.ReadASN1Integer(s) || !inner.Empty() {
+		return nil, nil, ErrInvalidDER
+	}
+
+	if r.Sign() <= 0 || s.Sign() <= 0 {
+		return nil, nil, ErrInvalidDER
+	}
+
+	return r, s, nil
+}
+
+// MarshalDER returns the DER encoding of ECDSA-Sig-Value ::= SEQUENCE { r INTEGER, s INTEGER }.
+func MarshalDER(r, s *big.Int) []byte {
+	var b cryptobyte.Builder
+
+	b.AddASN1(asn1.SEQUENCE, func(b *cryptobyte.Builder) {
+		b.AddASN1BigInt(r)
+		b.AddASN1BigInt(s)
+	})
+
+	return b.BytesOrPanic()
+}
+
+// DERToFixed converts the DER encoded signature to r || s where each is the size of the curve order n.
+func DERToFixed(der []byte, n *big.Int) ([]byte, error) {
+	r, s, err := ParseDER(der)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkRange(r, s, n); err != nil {
+		return nil, err
+	}
+
+	size := (n.BitLen() + 7) / 8
+	out := make([]byte, 2*size)
+	r.FillBytes(out[:size])
+	s.FillBytes(out[size:])
+
+	return out, nil
+}
+
+// FixedToDER converts the r || s signature, where each is the size of the curve order n, to DER.
+func FixedToDER(sig []byte, n *big.Int) ([]byte, error) {
+	r, s, err := ParseFixed(sig, n)
+	if err != nil {
+		return nil, err
+	}
+
+	return MarshalDER(r, s), nil
+}
+
+// ParseFixed parses the r || s signature, where each is the size of the curve order n.
+// The length is checked before slicing and r and s must be between 1 and n - 1.
+func ParseFixed(sig []byte, n *big.Int) (r, s *big.Int, err error) {
+	size := (n.BitLen() + 7) / 8
+	if len(sig) != 2*size {
+		return nil, nil, ErrInvalidFixed
+	}
+
+	r = new(big.Int).SetBytes(sig[:size])
+	s = new(big.Int).SetBytes(sig[size:])
+
+	if err := checkRange(r, s, n); err != nil {
+		return nil, nil, err
+	}
+
+	return r, s, nil
+}
+
+// IsLowS returns true when s is at most n / 2, the form signatures are normalized to so they are not malleable.
+func IsLowS(s, n *big.Int) bool {
+	return s.Cmp(new(big.Int).Rsh(n, 1)) <= 0
+}
+
+func checkRange(r, s, n *big.Int) error {
+	if r.Sign() <= 0 || s.Sign() <= 0 || r.Cmp(n) >= 0 || s.Cmp(n) >= 0 {
+		return ErrOutOfRange
+	}
+
+	return nil
+}
@@ -0,0 +1,146 @@
+package ecdsasig
+
+import (
+	"crypto/elliptic"
+	"math/big"
+	"testing"
+
+	"github.com/mailchain/go-encoding/encodingtest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseDER(t *testing.T) {
+	tests := []struct {
+		name    string
+		der     string
+		wantR   *big.Int
+		wantS   *big.Int
+		wantErr error
+	}{
+		{
+			"one-one",
+			"3006020101020101",
+			big.NewInt(1),
+			big.NewInt(1),
+			nil,
+		},
+		{
+			"high-bit-padded",
+			"30080202008002020100",
+			big.NewInt(0x80),
+			big.NewInt(0x100),
+			nil,
+		},
+		{
+			"err-non-minimal-integer",
+			"300702020001020101",
+			nil,
+			nil,
+			ErrInvalidDER,
+		},
+		{
+			"err-negative-integer",
+			"3006020181020101",
+			nil,
+			nil,
+			ErrInvalidDER,
+		},
+		{
+			"err-zero-integer",
+			"3006020100020101",
+			nil,
+			nil,
+			ErrInvalidDER,
+		},
+		{
+			"err-non-minimal-length",
+			"308106020101020101",
+			nil,
+			nil,
+			ErrInvalidDER,
+		},
+		{
+			"err-trailing-data",
+			"300602010102010100",
+			nil,
+			nil,
+			ErrInvalidDER,
+		},
+		{
+			"err-trailing-inner-data",
+			"3009020101020101020101",
+			nil,
+			nil,
+			ErrInvalidDER,
+		},
+		{
+			"err-not-sequence",
+			"3106020101020101",
+			nil,
+			nil,
+			ErrInvalidDER,
+		},
+		{
+			"err-missing-s",
+			"3003020101",
+			nil,
+			nil,
+			ErrInvalidDER,
+		},
+		{
+			"err-empty",
+			"",
+			nil,
+			nil,
+			ErrInvalidDER,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotR, gotS, err := ParseDER(encodingtest.MustDecodeHex(tt.der))
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.wantR, gotR)
+			assert.Equal(t, tt.wantS, gotS)
+
+			if tt.wantErr == nil {
+				assert.Equal(t, encodingtest.MustDecodeHex(tt.der), MarshalDER(gotR, gotS))
+			}
+		})
+	}
+}
+
+func TestFixedToDER(t *testing.T) {
+	n := elliptic.P256().Params().N
+	fixed := encodingtest.MustDecodeHex("efd48b2aacb6a8fd1140dd9cd45e81d69d2c877b56aaf991c34d0ea84eaf37160834e36ad29a83be2bc9385e491d6099c68f9a2c7a0e41c9d8ff8c0f12a1b2c3")
+
+	der, err := FixedToDER(fixed, n)
+	assert.NoError(t, err)
+	assert.Equal(t, encodingtest.MustDecodeHex("3045022100efd48b2aacb6a8fd1140dd9cd45e81d69d2c877b56aaf991c34d0ea84eaf371602200834e36ad29a83be2bc9385e491d6099c68f9a2c7a0e41c9d8ff8c0f12a1b2c3"), der)
+
+	got, err := DERToFixed(der, n)
+	assert.NoError(t, err)
+	assert.Equal(t, fixed, got)
+
+	_, err = FixedToDER(fixed[:63], n)
+	assert.ErrorIs(t, err, ErrInvalidFixed)
+
+	_, err = FixedToDER(make([]byte, 64), n)
+	assert.ErrorIs(t, err, ErrOutOfRange)
+
+	outOfRange := append(n.FillBytes(make([]byte, 32)), fixed[32:]...)
+	_, err = FixedToDER(outOfRange, n)
+	assert.ErrorIs(t, err, ErrOutOfRange)
+
+	_, err = DERToFixed(MarshalDER(n, big.NewInt(1)), n)
+	assert.ErrorIs(t, err, ErrOutOfRange)
+}
+
+func TestIsLowS(t *testing.T) {
+	n := elliptic.P256().Params().N
+	half := new(big.Int).Rsh(n, 1)
+
+	assert.True(t, IsLowS(big.NewInt(1), n))
+	assert.True(t, IsLowS(half, n))
+	assert.False(t, IsLowS(new(big.Int).Add(half, big.NewInt(1)), n))
+	assert.False(t, IsLowS(new(big.Int).Sub(n, big.NewInt(1)), n))
+}
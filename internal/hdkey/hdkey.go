@@ -0,0 +1,176 @@
+// Package hdkey contains the building blocks shared by the hierarchical deterministic key
+// implementations, BIP-32 and SLIP-0010.
+package hdkey
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/binary"
+	"errors"
+
+	"github.com/mailchain/go-encoding"
+	"golang.org/x/crypto/ripemd160" //nolint: staticcheck BIP-32 fingerprints are defined using HASH160
+)
+
+// HardenedKeyStart is the index at which hardened keys begin.
+const HardenedKeyStart uint32 = 0x80000000
+
+const (
+	// MaxDepth is the maximum depth of an extended key as the depth is serialized as a single byte.
+	MaxDepth = 255
+	// FingerprintLength is the length of a key fingerprint.
+	FingerprintLength = 4
+	// ChainCodeLength is the length of a chain code.
+	ChainCodeLength = 32
+
+	// metadataLength is the length of depth, parent fingerprint, child number and chain code when serialized.
+	metadataLength = 1 + FingerprintLength + 4 + ChainCodeLength
+	keyDataLength  = 33
+	checksumLength = 4
+)
+
+var (
+	// ErrInvalidChild is returned when the derived key is not valid for the requested index.
+	// As defined in BIP-32 the caller should proceed with the next index.
+	ErrInvalidChild = errors.New("derived key is invalid, proceed with the next index")
+	// ErrDeriveHardenedFromPublic is returned when a hardened child is requested from an extended public key.
+	ErrDeriveHardenedFromPublic = errors.New("cannot derive a hardened key from a public key")
+	// ErrMaxDepth is returned when deriving beyond the maximum depth.
+	ErrMaxDepth = errors.New("cannot derive a key with more than 255 indices in its path")
+	// ErrInvalidExtendedKey is returned when a serialized extended key is malformed.
+	ErrInvalidExtendedKey = errors.New("invalid extended key")
+	// ErrInvalidChecksum is returned when the checksum of a serialized extended key does not match.
+	ErrInvalidChecksum = errors.New("extended key checksum does not match")
+)
+
+// Metadata holds the fields shared by all extended keys regardless of curve.
+type Metadata struct {
+	ChainCode         []byte
+	Depth             uint8
+	ParentFingerprint []byte
+	ChildNumber       uint32
+}
+
+// Master returns the metadata of a master key with the supplied chain code.
+func Master(chainCode []byte) Metadata {
+	return Metadata{
+		ChainCode:         chainCode,
+		ParentFingerprint: make([]byte, FingerprintLength),
+	}
+}
+
+// Child returns the metadata of the child at index, parentPublicKey is used to calculate the parent fingerprint.
+func (m Metadata) Child(parentPublicKey []byte, index uint32, chainCode []byte) (Metadata, error) {
+	if m.Depth == MaxDepth {
+		return Metadata{}, ErrMaxDepth
+	}
+
+	return Metadata{
+		ChainCode:         chainCode,
+		Depth:             m.Depth + 1,
+		ParentFingerprint: Fingerprint(parentPublicKey),
+		ChildNumber:       index,
+	}, nil
+}
+
+// Serialize returns the BIP-32 serialization of the extended key, version is omitted when empty.
+func (m Metadata) Serialize(version, keyData []byte) []byte {
+	out := make([]byte, 0, len(version)+metadataLength+len(keyData))
+	out = append(out, version...)
+	out = append(out, m.Depth)
+	out = append(out, m.ParentFingerprint...)
+	out = append(out, Uint32Bytes(m.ChildNumber)...)
+	out = append(out, m.ChainCode...)
+	out = append(out, keyData...)
+
+	return out
+}
+
+// Deserialize parses a BIP-32 serialized extended key returning the metadata and the 33 byte key data.
+func Deserialize(in, version []byte) (Metadata, []byte, error) {
+	if len(in) != len(version)+metadataLength+keyDataLength {
+		return Metadata{}, nil, ErrInvalidExtendedKey
+	}
+
+	if !bytes.Equal(in[:len(version)], version) {
+		return Metadata{}, nil, ErrInvalidExtendedKey
+	}
+
+	in = in[len(version):]
+	m := Metadata{
+		Depth:             in[0],
+		ParentFingerprint: append([]byte{}, in[1:5]...),
+		ChildNumber:       binary.BigEndian.Uint32(in[5:9]),
+		ChainCode:         append([]byte{}, in[9:41]...),
+	}
+
+	if m.Depth == 0 && (m.ChildNumber != 0 || !bytes.Equal(m.ParentFingerprint, make([]byte, FingerprintLength))) {
+		return Metadata{}, nil, ErrInvalidExtendedKey
+	}
+
+	return m, append([]byte{}, in[41:]...), nil
+}
+
+// IsHardened reports whether index is a hardened index.
+func IsHardened(index uint32) bool {
+	return index >= HardenedKeyStart
+}
+
+// Uint32Bytes returns the big endian representation of in.
+func Uint32Bytes(in uint32) []byte {
+	out := make([]byte, 4)
+	binary.BigEndian.PutUint32(out, in)
+
+	return out
+}
+
+// HMACSHA512 returns the left and right halves of HMAC-SHA512(key, data).
+func HMACSHA512(key, data []byte) (il, ir []byte) {
+	mac := hmac.New(sha512.New, key)
+	mac.Write(data) //nolint: errcheck hash writes never return an error
+	sum := mac.Sum(nil)
+
+	return sum[:32], sum[32:]
+}
+
+// Fingerprint is the first 4 bytes of HASH160 of the serialized public key.
+func Fingerprint(publicKey []byte) []byte {
+	sha := sha256.Sum256(publicKey)
+	h := ripemd160.New()
+	h.Write(sha[:]) //nolint: errcheck hash writes never return an error
+
+	return h.Sum(nil)[:FingerprintLength]
+}
+
+// EncodeBase58Check encodes in as base58 with a double SHA-256 checksum appended.
+func EncodeBase58Check(in []byte) string {
+	return encoding.EncodeBase58(append(append([]byte{}, in...), checksum(in)...))
+}
+
+// DecodeBase58Check decodes a base58 string and verifies its double SHA-256 checksum.
+func DecodeBase58Check(in string) ([]byte, error) {
+	decoded, err := encoding.DecodeBase58(in)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(decoded) < checksumLength {
+		return nil, ErrInvalidExtendedKey
+	}
+
+	data := decoded[:len(decoded)-checksumLength]
+	if !bytes.Equal(checksum(data), decoded[len(decoded)-checksumLength:]) {
+		return nil, ErrInvalidChecksum
+	}
+
+	return data, nil
+}
+
+func checksum(in []byte) []byte {
+	first := sha256.Sum256(in)
+	second := sha256.Sum256(first[:])
+
+	return second[:checksumLength]
+}
@@ -0,0 +1,113 @@
+package schnorrkel
+
+import (
+	"errors"
+	"io"
+
+	"github.com/gtank/ristretto255"
+	"github.com/mailchain/go-crypto/merlin"
+)
+
+const (
+	// ChainCodeLength is the length of a chain code.
+	ChainCodeLength = 32
+	// MiniSecretKeyLength is the length of a mini secret key.
+	MiniSecretKeyLength = 32
+)
+
+// ErrInvalidChainCode is returned when the chain code is not 32 bytes.
+var ErrInvalidChainCode = errors.New("chain code must be 32 bytes")
+
+// HardDeriveMiniSecretKey derives a mini secret key and chain code from the secret key.
+// The mini secret key must be expanded to create the child secret key.
+//
+// https://github.com/w3f/schnorrkel/blob/4112f6e8cb684a1cc6574f9097497e1e302ab9a8/src/derive.rs
+func (sk *SecretKey) HardDeriveMiniSecretKey(chainCode, i []byte) (miniSecretKey, childChainCode [32]byte, err error) {
+	if len(chainCode) != ChainCodeLength {
+		return miniSecretKey, childChainCode, ErrInvalidChainCode
+	}
+
+	t := newHDKDTranscript(i)
+	t.AppendMessage([]byte("chain-code"), chainCode)
+	t.AppendMessage([]byte("secret-key"), sk.key[:])
+
+	copy(miniSecretKey[:], t.ExtractBytes([]byte("HDKD-hard"), MiniSecretKeyLength))
+	copy(childChainCode[:], t.ExtractBytes([]byte("HDKD-chaincode"), ChainCodeLength))
+
+	return miniSecretKey, childChainCode, nil
+}
+
+// DerivedKeySimple soft derives a child secret key and chain code from the secret key.
+// The child nonce is a witness of the derivation transcript, the parent nonce and secret key, and randomness read from rand.
+//
+// https://github.com/w3f/schnorrkel/blob/4112f6e8cb684a1cc6574f9097497e1e302ab9a8/src/derive.rs
+func (sk *SecretKey) DerivedKeySimple(publicKey, chainCode, i []byte, rand io.Reader) (SecretKey, [32]byte, error) {
+	t := newHDKDTranscript(i)
+
+	scalar, childChainCode, err := deriveScalarAndChainCode(t, publicKey, chainCode)
+	if err != nil {
+		return SecretKey{}, childChainCode, err
+	}
+
+	key := ristretto255.NewScalar()
+	if err := key.Decode(sk.key[:]); err != nil {
+		return SecretKey{}, childChainCode, err
+	}
+
+	// the witness keeps the nonce independent from the child key and chain code.
+	nonce, err := WitnessBytes(t, []byte("HDKD-nonce"), 32, [][]byte{sk.nonce[:], sk.Bytes()}, rand)
+	if err != nil {
+		return SecretKey{}, childChainCode, err
+	}
+
+	childKey := [32]byte{}
+	childNonce := [32]byte{}
+
+	copy(childKey[:], key.Add(key, scalar).Encode([]byte{}))
+	copy(childNonce[:], nonce)
+
+	return NewSecretKey(childKey, childNonce), childChainCode, nil
+}
+
+// DerivedPublicKeySimple soft derives a child public key and chain code from the public key.
+// The child public key matches the public key of the child secret key returned by SecretKey.DerivedKeySimple.
+//
+// https://github.com/w3f/schnorrkel/blob/4112f6e8cb684a1cc6574f9097497e1e302ab9a8/src/derive.rs
+func DerivedPublicKeySimple(publicKey, chainCode, i []byte) ([]byte, [32]byte, error) {
+	scalar, childChainCode, err := deriveScalarAndChainCode(newHDKDTranscript(i), publicKey, chainCode)
+	if err != nil {
+		return nil, childChainCode, err
+	}
+
+	point := ristretto255.NewElement()
+	if err := point.Decode(publicKey); err != nil {
+		return nil, childChainCode, err
+	}
+
+	point.Add(point, ristretto255.NewElement().ScalarBaseMult(scalar))
+
+	return point.Encode([]byte{}), childChainCode, nil
+}
+
+// https://github.com/w3f/schnorrkel/blob/4112f6e8cb684a1cc6574f9097497e1e302ab9a8/src/derive.rs
+func deriveScalarAndChainCode(t *merlin.Transcript, publicKey, chainCode []byte) (*ristretto255.Scalar, [32]byte, error) {
+	childChainCode := [32]byte{}
+	if len(chainCode) != ChainCodeLength {
+		return nil, childChainCode, ErrInvalidChainCode
+	}
+
+	t.AppendMessage([]byte("chain-code"), chainCode)
+	t.AppendMessage([]byte("public-key"), publicKey)
+
+	scalar := ristretto255.NewScalar().FromUniformBytes(t.ExtractBytes([]byte("HDKD-scalar"), 64))
+	copy(childChainCode[:], t.ExtractBytes([]byte("HDKD-chaincode"), ChainCodeLength))
+
+	return scalar, childChainCode, nil
+}
+
+func newHDKDTranscript(i []byte) *merlin.Transcript {
+	t := merlin.NewTranscript("SchnorrRistrettoHDKD")
+	t.AppendMessage([]byte("sign-bytes"), i)
+
+	return t
+}
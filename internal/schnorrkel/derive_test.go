@@ -0,0 +1,58 @@
+package schnorrkel
+
+import (
+	"crypto/rand"
+	"testing"
+
+	"github.com/gtank/ristretto255"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDerivedKeySimple(t *testing.T) {
+	seed := [32]byte{0xfa, 0xc7, 0x95, 0x9d, 0xbf, 0xe7, 0x2f, 0x05, 0x2e, 0x5a, 0x0c, 0x3c, 0x8d, 0x65, 0x30, 0xf2, 0x02, 0xb0, 0x2f, 0xd8, 0xf9, 0xf5, 0xca, 0x35, 0x80, 0xec, 0x8d, 0xeb, 0x77, 0x97, 0x47, 0x9e}
+	sk := NewSecretKeySR25519(seed)
+	chainCode := make([]byte, ChainCodeLength)
+
+	key := ristretto255.NewScalar()
+	require.NoError(t, key.Decode(sk.Key()))
+	publicKey := ristretto255.NewElement().ScalarBaseMult(key).Encode(nil)
+
+	child, childChainCode, err := sk.DerivedKeySimple(publicKey, chainCode, nil, rand.Reader)
+	require.NoError(t, err)
+	assert.Nil(t, child.Seed())
+	assert.Len(t, child.Bytes(), 64)
+
+	childPublicKey, publicChainCode, err := DerivedPublicKeySimple(publicKey, chainCode, nil)
+	require.NoError(t, err)
+	assert.Equal(t, publicChainCode, childChainCode)
+
+	childKey := ristretto255.NewScalar()
+	require.NoError(t, childKey.Decode(child.Key()))
+	assert.Equal(t, childPublicKey, ristretto255.NewElement().ScalarBaseMult(childKey).Encode(nil))
+
+	_, _, err = sk.DerivedKeySimple(publicKey, chainCode[1:], nil, rand.Reader)
+	assert.Equal(t, ErrInvalidChainCode, err)
+	_, _, err = DerivedPublicKeySimple(publicKey, chainCode[1:], nil)
+	assert.Equal(t, ErrInvalidChainCode, err)
+}
+
+func TestHardDeriveMiniSecretKey(t *testing.T) {
+	sk := NewSecretKeySR25519([32]byte{})
+	chainCode := make([]byte, ChainCodeLength)
+
+	first, firstChainCode, err := sk.HardDeriveMiniSecretKey(chainCode, nil)
+	require.NoError(t, err)
+	second, secondChainCode, err := sk.HardDeriveMiniSecretKey(chainCode, nil)
+	require.NoError(t, err)
+	assert.Equal(t, first, second)
+	assert.Equal(t, firstChainCode, secondChainCode)
+
+	chainCode[0] = 1
+	other, _, err := sk.HardDeriveMiniSecretKey(chainCode, nil)
+	require.NoError(t, err)
+	assert.NotEqual(t, first, other)
+
+	_, _, err = sk.HardDeriveMiniSecretKey(chainCode[1:], nil)
+	assert.Equal(t, ErrInvalidChainCode, err)
+}
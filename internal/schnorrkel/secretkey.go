@@ -7,13 +7,21 @@ type SecretKey struct {
 	seed  [32]byte
 	key   [32]byte
 	nonce [32]byte
+	// withoutSeed is set when the secret key was not expanded from a mini secret key, for example soft derived keys.
+	withoutSeed bool
 }
 
 func (sk *SecretKey) Key() []byte {
 	return sk.key[:]
 }
 
+// Seed returns the mini secret key the secret key was expanded from, nil is returned when the secret key
+// has no seed, for example soft derived keys.
 func (sk *SecretKey) Seed() []byte {
+	if sk.withoutSeed {
+		return nil
+	}
+
 	return sk.seed[:]
 }
 
@@ -21,6 +29,20 @@ func (sk *SecretKey) Nonce() []byte {
 	return sk.nonce[:]
 }
 
+// Bytes returns the secret scalar followed by the nonce.
+// https://github.com/w3f/schnorrkel/blob/4112f6e8cb684a1cc6574f9097497e1e302ab9a8/src/keys.rs
+func (sk *SecretKey) Bytes() []byte {
+	out := make([]byte, 0, 64)
+	out = append(out, sk.key[:]...)
+
+	return append(out, sk.nonce[:]...)
+}
+
+// NewSecretKey creates a secret key from a secret scalar and nonce without a seed.
+func NewSecretKey(key, nonce [32]byte) SecretKey {
+	return SecretKey{key: key, nonce: nonce, withoutSeed: true}
+}
+
 func NewSecretKeySR25519(seed [32]byte) SecretKey {
 	key := [32]byte{}
 	nonce := [32]byte{}
@@ -0,0 +1,44 @@
+package schnorrkel
+
+import (
+	"io"
+
+	"github.com/gtank/ristretto255"
+	"github.com/mailchain/go-crypto/merlin"
+)
+
+// WitnessBytes fills a buffer of length with bytes from a transcript RNG.
+// The RNG is bound to the transcript, each nonce seed is mixed in with the label then 32 bytes are read from rand.
+// A weak rand does not reveal the secret key as long as a nonce seed is secret.
+//
+// https://github.com/w3f/schnorrkel/blob/4112f6e8cb684a1cc6574f9097497e1e302ab9a8/src/context.rs
+func WitnessBytes(t *merlin.Transcript, label []byte, length int, nonceSeeds [][]byte, rand io.Reader) ([]byte, error) {
+	builder := t.BuildRNG()
+	for _, nonceSeed := range nonceSeeds {
+		builder = builder.RekeyWithWitnessBytes(label, nonceSeed)
+	}
+
+	rng, err := builder.Finalize(rand)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]byte, length)
+	if _, err := rng.Read(out); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+// WitnessScalar returns a scalar created from 64 bytes of WitnessBytes.
+//
+// https://github.com/w3f/schnorrkel/blob/4112f6e8cb684a1cc6574f9097497e1e302ab9a8/src/context.rs
+func WitnessScalar(t *merlin.Transcript, label []byte, nonceSeeds [][]byte, rand io.Reader) (*ristretto255.Scalar, error) {
+	b, err := WitnessBytes(t, label, 64, nonceSeeds, rand)
+	if err != nil {
+		return nil, err
+	}
+
+	return ristretto255.NewScalar().FromUniformBytes(b), nil
+}
@@ -0,0 +1,54 @@
+package schnorrkel
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/mailchain/go-crypto/merlin"
+	"github.com/mailchain/go-encoding/encodingtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWitnessScalar(t *testing.T) {
+	transcript := func() *merlin.Transcript {
+		tr := merlin.NewTranscript("SigningContext")
+		tr.AppendMessage([]byte("sign-bytes"), []byte("message"))
+
+		return tr
+	}
+	nonce := bytes.Repeat([]byte{0x42}, 32)
+
+	got, err := WitnessScalar(transcript(), []byte("signing"), [][]byte{nonce}, bytes.NewReader(make([]byte, 32)))
+	require.NoError(t, err)
+	// generated with the witness_scalar port of github.com/oasisprotocol/curve25519-voi/primitives/sr25519.
+	assert.Equal(t, encodingtest.MustDecodeHex("ef4268e032333e15d196d39602e6d43d905a100932ec3c04fe4723d955e5a00a"), got.Encode(nil))
+
+	same, err := WitnessScalar(transcript(), []byte("signing"), [][]byte{nonce}, bytes.NewReader(make([]byte, 32)))
+	require.NoError(t, err)
+	assert.Equal(t, got.Encode(nil), same.Encode(nil))
+
+	otherNonce, err := WitnessScalar(transcript(), []byte("signing"), [][]byte{nonce[1:]}, bytes.NewReader(make([]byte, 32)))
+	require.NoError(t, err)
+	assert.NotEqual(t, got.Encode(nil), otherNonce.Encode(nil))
+
+	otherLabel, err := WitnessScalar(transcript(), []byte("other"), [][]byte{nonce}, bytes.NewReader(make([]byte, 32)))
+	require.NoError(t, err)
+	assert.NotEqual(t, got.Encode(nil), otherLabel.Encode(nil))
+
+	_, err = WitnessScalar(transcript(), []byte("signing"), [][]byte{nonce}, bytes.NewReader(make([]byte, 8)))
+	assert.Error(t, err)
+}
+
+func TestWitnessBytes(t *testing.T) {
+	tr := merlin.NewTranscript("SchnorrRistrettoHDKD")
+
+	got, err := WitnessBytes(tr, []byte("HDKD-nonce"), 32, [][]byte{{1}, {2}}, bytes.NewReader(make([]byte, 32)))
+	require.NoError(t, err)
+	// generated with the witness_bytes port of github.com/oasisprotocol/curve25519-voi/primitives/sr25519.
+	assert.Equal(t, encodingtest.MustDecodeHex("d4e82969f984132ebae5f2161cd795b5b50e1ba37b2abdcf618674e3840f8a0d"), got)
+
+	swapped, err := WitnessBytes(tr, []byte("HDKD-nonce"), 32, [][]byte{{2}, {1}}, bytes.NewReader(make([]byte, 32)))
+	require.NoError(t, err)
+	assert.NotEqual(t, got, swapped)
+}
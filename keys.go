@@ -26,6 +26,9 @@ const (
 	IDNonSpecified = 0xee
 )
 
+// CurveKindIDMapping maps the built in key kinds to their ID byte.
+//
+// Deprecated: use multikey.KeyKinds which also includes key kinds registered with multikey.RegisterKeyKind.
 var CurveKindIDMapping = map[string]byte{ //nolint:gochecknoglobals
 	KindSECP256K1: IDSECP256K1,
 	KindED25519:   IDED25519,
@@ -34,6 +37,8 @@ var CurveKindIDMapping = map[string]byte{ //nolint:gochecknoglobals
 }
 
 // KeyTypes available key types.
+//
+// Deprecated: use multikey.KeyKinds which also includes key kinds registered with multikey.RegisterKeyKind.
 func KeyTypes() map[string]bool {
 	return map[string]bool{
 		KindSECP256K1: true,
@@ -0,0 +1,98 @@
+// Package merlin implements merlin transcripts, see https://merlin.cool.
+// Unlike github.com/gtank/merlin it supports the transcript RNG that schnorrkel uses to create witnesses.
+package merlin
+
+import (
+	"encoding/binary"
+	"io"
+
+	"github.com/mimoo/StrobeGo/strobe"
+)
+
+const (
+	merlinProtocolLabel  = "Merlin v1.0"
+	domainSeparatorLabel = "dom-sep"
+	rngSeedLength        = 32
+)
+
+// Transcript is a merlin transcript, messages are appended to the transcript and challenges are extracted from it.
+type Transcript struct {
+	s *strobe.Strobe
+}
+
+// NewTranscript creates a transcript with the application label as the domain separator.
+func NewTranscript(appLabel string) *Transcript {
+	s := strobe.InitStrobe(merlinProtocolLabel, 128)
+	t := Transcript{s: &s}
+
+	t.AppendMessage([]byte(domainSeparatorLabel), []byte(appLabel))
+
+	return &t
+}
+
+// AppendMessage adds the message to the transcript with the supplied label.
+func (t *Transcript) AppendMessage(label, message []byte) {
+	// AD[label || le32(len(message))](message)
+	// StrobeGo does not support continuation operations so the label and length are a single meta-AD operation.
+	t.s.AD(true, append(append([]byte{}, label...), le32(len(message))...))
+	t.s.AD(false, message)
+}
+
+// ExtractBytes returns outLen challenge bytes, the label is appended to the transcript.
+func (t *Transcript) ExtractBytes(label []byte, outLen int) []byte {
+	t.s.AD(true, append(append([]byte{}, label...), le32(outLen)...))
+
+	return t.s.PRF(outLen)
+}
+
+// BuildRNG creates a builder for an RNG that is bound to the current state of the transcript.
+// The transcript is not modified.
+func (t *Transcript) BuildRNG() *RNGBuilder {
+	return &RNGBuilder{s: t.s.Clone()}
+}
+
+// RNGBuilder rekeys a transcript RNG with secret witness data before it is finalized with external randomness.
+type RNGBuilder struct {
+	s *strobe.Strobe
+}
+
+// RekeyWithWitnessBytes mixes the witness into the RNG with the supplied label.
+func (b *RNGBuilder) RekeyWithWitnessBytes(label, witness []byte) *RNGBuilder {
+	b.s.AD(true, append(append([]byte{}, label...), le32(len(witness))...))
+	b.s.KEY(witness)
+
+	return b
+}
+
+// Finalize mixes 32 bytes read from rand into the RNG and returns the RNG.
+func (b *RNGBuilder) Finalize(rand io.Reader) (*RNG, error) {
+	seed := make([]byte, rngSeedLength)
+	if _, err := io.ReadFull(rand, seed); err != nil {
+		return nil, err
+	}
+
+	b.s.AD(true, []byte("rng"))
+	b.s.KEY(seed)
+
+	return &RNG{s: b.s}, nil
+}
+
+// RNG is a transcript RNG, its output depends on the transcript, the witness data and the external randomness.
+type RNG struct {
+	s *strobe.Strobe
+}
+
+// Read fills p with random bytes, it never returns an error.
+func (r *RNG) Read(p []byte) (int, error) {
+	r.s.AD(true, le32(len(p)))
+	copy(p, r.s.PRF(len(p)))
+
+	return len(p), nil
+}
+
+func le32(n int) []byte {
+	out := make([]byte, 4)
+	binary.LittleEndian.PutUint32(out, uint32(n))
+
+	return out
+}
@@ -0,0 +1,95 @@
+package merlin
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/mailchain/go-encoding/encodingtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Transcript test vectors from https://github.com/dalek-cryptography/merlin/blob/master/src/transcript.rs
+func TestSimpleTranscript(t *testing.T) {
+	mt := NewTranscript("test protocol")
+	mt.AppendMessage([]byte("some label"), []byte("some data"))
+
+	assert.Equal(t, encodingtest.MustDecodeHex("d5a21972d0d5fe320c0d263fac7fffb8145aa640af6e9bca177c03c7efcf0615"), mt.ExtractBytes([]byte("challenge"), 32))
+}
+
+func TestComplexTranscript(t *testing.T) {
+	tr := NewTranscript("test protocol")
+	tr.AppendMessage([]byte("step1"), []byte("some data"))
+
+	data := bytes.Repeat([]byte{99}, 1024)
+
+	var chlBytes []byte
+	for i := 0; i < 32; i++ {
+		chlBytes = tr.ExtractBytes([]byte("challenge"), 32)
+		tr.AppendMessage([]byte("bigdata"), data)
+		tr.AppendMessage([]byte("challengedata"), chlBytes)
+	}
+
+	assert.Equal(t, encodingtest.MustDecodeHex("a8c933f54fae76e3f9bea93648c1308e7dfa2152dd51674ff3ca438351cf003c"), chlBytes)
+}
+
+func TestTranscriptRNG(t *testing.T) {
+	read := func(protocol, witness string, rand []byte) []byte {
+		tr := NewTranscript(protocol)
+		tr.AppendMessage([]byte("label"), []byte("message"))
+
+		rng, err := tr.BuildRNG().RekeyWithWitnessBytes([]byte("witness"), []byte(witness)).Finalize(bytes.NewReader(rand))
+		require.NoError(t, err)
+
+		out := make([]byte, 64)
+		n, err := rng.Read(out)
+		require.NoError(t, err)
+		require.Equal(t, 64, n)
+
+		return out
+	}
+
+	zero := make([]byte, 32)
+	one := bytes.Repeat([]byte{1}, 32)
+
+	got := read("protocol", "secret", zero)
+	assert.Equal(t, got, read("protocol", "secret", zero), "same inputs must give the same output")
+	assert.NotEqual(t, got, read("other protocol", "secret", zero), "output must be bound to the transcript")
+	assert.NotEqual(t, got, read("protocol", "other secret", zero), "output must be bound to the witness")
+	assert.NotEqual(t, got, read("protocol", "secret", one), "output must be bound to the external randomness")
+
+	tr := NewTranscript("protocol")
+	_, err := tr.BuildRNG().Finalize(bytes.NewReader(make([]byte, 31)))
+	assert.Error(t, err)
+}
+
+func TestTranscriptRNGKnownAnswer(t *testing.T) {
+	// generated with the TranscriptRng port of github.com/oasisprotocol/curve25519-voi/primitives/merlin,
+	// the external randomness is read from a zero filled reader.
+	tr := NewTranscript("protocol")
+	tr.AppendMessage([]byte("label"), []byte("message"))
+
+	rng, err := tr.BuildRNG().RekeyWithWitnessBytes([]byte("witness"), []byte("secret")).Finalize(bytes.NewReader(make([]byte, 32)))
+	require.NoError(t, err)
+
+	first := make([]byte, 64)
+	_, err = rng.Read(first)
+	require.NoError(t, err)
+	assert.Equal(t, encodingtest.MustDecodeHex("de4ca94d795ea508f950d3915bb9031001855e2dd41abe67c2f1073938f2f61e6ac68bcd742e52eddfd4aea901539ffb6dec5e66618f461a58f9fc2e30004fc6"), first)
+
+	second := make([]byte, 32)
+	_, err = rng.Read(second)
+	require.NoError(t, err)
+	assert.Equal(t, encodingtest.MustDecodeHex("774a6d6802a5bb708bb9598385f81c4610365c6bce6e905c2c8d88aa91d7dc85"), second)
+}
+
+func TestBuildRNGDoesNotModifyTranscript(t *testing.T) {
+	tr := NewTranscript("test protocol")
+	tr.AppendMessage([]byte("some label"), []byte("some data"))
+
+	rng, err := tr.BuildRNG().RekeyWithWitnessBytes([]byte("witness"), []byte("secret")).Finalize(bytes.NewReader(make([]byte, 32)))
+	require.NoError(t, err)
+	_, _ = rng.Read(make([]byte, 32))
+
+	assert.Equal(t, encodingtest.MustDecodeHex("d5a21972d0d5fe320c0d263fac7fffb8145aa640af6e9bca177c03c7efcf0615"), tr.ExtractBytes([]byte("challenge"), 32))
+}
@@ -1,11 +1,165 @@
 package mnemonic
 
 import (
+	"crypto/sha256"
 	"crypto/sha512"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
 
 	"golang.org/x/crypto/pbkdf2"
+	"golang.org/x/text/unicode/norm"
 )
 
+const (
+	// MinEntropyBits is the smallest entropy size, it results in a 12 word mnemonic.
+	MinEntropyBits = 128
+	// MaxEntropyBits is the largest entropy size, it results in a 24 word mnemonic.
+	MaxEntropyBits = 256
+
+	bitsPerWord     = 11
+	seedIterations  = 2048
+	bip39SeedLength = 64
+)
+
+var (
+	// ErrInvalidEntropyLength is returned when the entropy is not a multiple of 32 bits between 128 and 256 bits.
+	ErrInvalidEntropyLength = errors.New("entropy length must be a multiple of 32 bits between 128 and 256 bits")
+	// ErrInvalidWordCount is returned when a mnemonic does not have 12, 15, 18, 21 or 24 words.
+	ErrInvalidWordCount = errors.New("mnemonic must have 12, 15, 18, 21 or 24 words")
+	// ErrUnknownWord is returned when a mnemonic contains a word that is not in the wordlist.
+	ErrUnknownWord = errors.New("word is not in the wordlist")
+	// ErrInvalidChecksum is returned when the checksum of a mnemonic does not match its entropy.
+	ErrInvalidChecksum = errors.New("invalid mnemonic checksum")
+)
+
+// ToSeed returns the first 32 bytes of the seed created from the mnemonic and password.
+// The mnemonic is not validated, use ToEntropy or Validate to check the mnemonic first.
 func ToSeed(mnemonic string, password string) []byte {
 	return pbkdf2.Key([]byte(mnemonic), []byte("mnemonic"+password), 2048, 32, sha512.New)
 }
+
+// ToBIP39Seed returns the 64 byte BIP-39 seed created from the mnemonic and password.
+// Both the mnemonic and password are NFKD normalized. The mnemonic is not validated,
+// use ToCheckedBIP39Seed to reject mnemonics with unknown words or an invalid checksum.
+func ToBIP39Seed(mnemonic string, password string) []byte {
+	return pbkdf2.Key(
+		[]byte(norm.NFKD.String(mnemonic)),
+		[]byte("mnemonic"+norm.NFKD.String(password)),
+		seedIterations,
+		bip39SeedLength,
+		sha512.New,
+	)
+}
+
+// ToCheckedBIP39Seed validates the mnemonic against the wordlist then returns the 64 byte BIP-39 seed.
+func ToCheckedBIP39Seed(mnemonic string, password string, wordlist *Wordlist) ([]byte, error) {
+	if err := Validate(mnemonic, wordlist); err != nil {
+		return nil, err
+	}
+
+	return ToBIP39Seed(mnemonic, password), nil
+}
+
+// GenerateEntropy reads bitSize bits of entropy from rand.
+// The bitSize must be a multiple of 32 between 128 and 256.
+func GenerateEntropy(rand io.Reader, bitSize int) ([]byte, error) {
+	if err := validateEntropyBits(bitSize); err != nil {
+		return nil, err
+	}
+
+	entropy := make([]byte, bitSize/8)
+	if _, err := io.ReadFull(rand, entropy); err != nil {
+		return nil, err
+	}
+
+	return entropy, nil
+}
+
+// New creates a mnemonic with bitSize bits of entropy read from rand.
+func New(rand io.Reader, bitSize int, wordlist *Wordlist) (string, error) {
+	entropy, err := GenerateEntropy(rand, bitSize)
+	if err != nil {
+		return "", err
+	}
+
+	return FromEntropy(entropy, wordlist)
+}
+
+// FromEntropy encodes the entropy and its checksum as a mnemonic using the words from the wordlist.
+func FromEntropy(entropy []byte, wordlist *Wordlist) (string, error) {
+	entropyBits := len(entropy) * 8
+	if err := validateEntropyBits(entropyBits); err != nil {
+		return "", err
+	}
+
+	checksumBits := entropyBits / 32
+	hash := sha256.Sum256(entropy)
+	data := append(append([]byte{}, entropy...), hash[0])
+
+	words := make([]string, (entropyBits+checksumBits)/bitsPerWord)
+	for i := range words {
+		index := 0
+		for bit := i * bitsPerWord; bit < (i+1)*bitsPerWord; bit++ {
+			index = index<<1 | int(data[bit/8]>>(7-bit%8)&1)
+		}
+
+		words[i] = wordlist.words[index]
+	}
+
+	return strings.Join(words, wordlist.separator), nil
+}
+
+// ToEntropy decodes the mnemonic using the wordlist and returns its entropy.
+// The mnemonic is NFKD normalized and an error is returned when the checksum does not match.
+func ToEntropy(mnemonic string, wordlist *Wordlist) ([]byte, error) {
+	words := strings.Fields(norm.NFKD.String(mnemonic))
+	if len(words)%3 != 0 || len(words) < 12 || len(words) > 24 {
+		return nil, ErrInvalidWordCount
+	}
+
+	totalBits := len(words) * bitsPerWord
+	checksumBits := totalBits / 33
+	entropyBits := totalBits - checksumBits
+
+	data := make([]byte, (totalBits+7)/8)
+	for i, word := range words {
+		index, ok := wordlist.index(word)
+		if !ok {
+			return nil, fmt.Errorf("%w: %q", ErrUnknownWord, word)
+		}
+
+		for j := 0; j < bitsPerWord; j++ {
+			bit := i*bitsPerWord + j
+			if index>>(bitsPerWord-1-j)&1 == 1 {
+				data[bit/8] |= 1 << (7 - bit%8)
+			}
+		}
+	}
+
+	entropy := data[:entropyBits/8]
+	hash := sha256.Sum256(entropy)
+	shift := 8 - checksumBits
+
+	if data[len(entropy)]>>shift != hash[0]>>shift {
+		return nil, ErrInvalidChecksum
+	}
+
+	return entropy, nil
+}
+
+// Validate returns an error when the mnemonic contains words that are not in the wordlist,
+// has an invalid number of words or has an invalid checksum.
+func Validate(mnemonic string, wordlist *Wordlist) error {
+	_, err := ToEntropy(mnemonic, wordlist)
+	return err
+}
+
+func validateEntropyBits(bitSize int) error {
+	if bitSize%32 != 0 || bitSize < MinEntropyBits || bitSize > MaxEntropyBits {
+		return ErrInvalidEntropyLength
+	}
+
+	return nil
+}
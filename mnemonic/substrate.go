@@ -0,0 +1,36 @@
+package mnemonic
+
+import (
+	"crypto/sha512"
+
+	"golang.org/x/crypto/pbkdf2"
+)
+
+const miniSecretLength = 32
+
+// SubstrateSeedFromEntropy returns the 64 byte seed that substrate creates from mnemonic entropy.
+// Unlike BIP-39, substrate runs PBKDF2 over the entropy instead of the mnemonic phrase,
+// see https://github.com/paritytech/substrate-bip39.
+func SubstrateSeedFromEntropy(entropy []byte, password string) ([]byte, error) {
+	if err := validateEntropyBits(len(entropy) * 8); err != nil {
+		return nil, err
+	}
+
+	return pbkdf2.Key(entropy, []byte("mnemonic"+password), seedIterations, bip39SeedLength, sha512.New), nil
+}
+
+// ToMiniSecret returns the 32 byte mini secret key that substrate, subkey and polkadot-js create from an english mnemonic.
+// The mini secret key is the seed for sr25519, ed25519 and ecdsa keys, sr25519.PrivateKeyFromBytes expects this value.
+func ToMiniSecret(mnemonic string, password string) ([]byte, error) {
+	entropy, err := ToEntropy(mnemonic, English)
+	if err != nil {
+		return nil, err
+	}
+
+	seed, err := SubstrateSeedFromEntropy(entropy, password)
+	if err != nil {
+		return nil, err
+	}
+
+	return seed[:miniSecretLength], nil
+}
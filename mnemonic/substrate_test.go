@@ -0,0 +1,90 @@
+package mnemonic
+
+import (
+	"testing"
+
+	"github.com/mailchain/go-encoding/encodingtest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSubstrateSeedFromEntropy(t *testing.T) {
+	// vectors from https://github.com/paritytech/substrate-bip39
+	tests := []struct {
+		name     string
+		entropy  []byte
+		password string
+		want     []byte
+		wantErr  error
+	}{
+		{
+			"00000000000000000000000000000000",
+			encodingtest.MustDecodeHex("00000000000000000000000000000000"),
+			"Substrate",
+			encodingtest.MustDecodeHex("44e9d125f037ac1d51f0a7d3649689d422c2af8b1ec8e00d71db4d7bf6d127e33f50c3d5c84fa3e5399c72d6cbbbbc4a49bf76f76d952f479d74655a2ef2d453"),
+			nil,
+		},
+		{
+			"7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f",
+			encodingtest.MustDecodeHex("7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f"),
+			"Substrate",
+			encodingtest.MustDecodeHex("4313249608fe8ac10fd5886c92c4579007272cb77c21551ee5b8d60b780416850f1e26c1f4b8d88ece681cb058ab66d6182bc2ce5a03181f7b74c27576b5c8bf"),
+			nil,
+		},
+		{
+			"err-entropy-length",
+			encodingtest.MustDecodeHex("0000000000000000"),
+			"Substrate",
+			nil,
+			ErrInvalidEntropyLength,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := SubstrateSeedFromEntropy(tt.entropy, tt.password)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestToMiniSecret(t *testing.T) {
+	tests := []struct {
+		name     string
+		mnemonic string
+		password string
+		want     []byte
+		wantErr  bool
+	}{
+		{
+			"dev-phrase",
+			"bottom drive obey lake curtain smoke basket hold race lonely fit walk",
+			"",
+			encodingtest.MustDecodeHex("fac7959dbfe72f052e5a0c3c8d6530f202b02fd8f9f5ca3580ec8deb7797479e"),
+			false,
+		},
+		{
+			"abandon-substrate",
+			"abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about",
+			"Substrate",
+			encodingtest.MustDecodeHex("44e9d125f037ac1d51f0a7d3649689d422c2af8b1ec8e00d71db4d7bf6d127e3"),
+			false,
+		},
+		{
+			"err-checksum",
+			"bottom drive obey lake curtain smoke basket hold race lonely fit fit",
+			"",
+			nil,
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ToMiniSecret(tt.mnemonic, tt.password)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ToMiniSecret() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
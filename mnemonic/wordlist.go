@@ -0,0 +1,74 @@
+package mnemonic
+
+import (
+	"embed"
+	"strings"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+//go:embed wordlists/*.txt
+var wordlistFiles embed.FS
+
+// Official BIP-39 wordlists, see https://github.com/bitcoin/bips/tree/master/bip-0039.
+var (
+	English            = mustLoadWordlist("english", " ")             //nolint: gochecknoglobals
+	Japanese           = mustLoadWordlist("japanese", "\u3000")       //nolint: gochecknoglobals
+	Korean             = mustLoadWordlist("korean", " ")              //nolint: gochecknoglobals
+	Spanish            = mustLoadWordlist("spanish", " ")             //nolint: gochecknoglobals
+	ChineseSimplified  = mustLoadWordlist("chinese_simplified", " ")  //nolint: gochecknoglobals
+	ChineseTraditional = mustLoadWordlist("chinese_traditional", " ") //nolint: gochecknoglobals
+	French             = mustLoadWordlist("french", " ")              //nolint: gochecknoglobals
+	Italian            = mustLoadWordlist("italian", " ")             //nolint: gochecknoglobals
+	Czech              = mustLoadWordlist("czech", " ")               //nolint: gochecknoglobals
+)
+
+const wordlistLength = 2048
+
+// Wordlist is a BIP-39 wordlist of 2048 words.
+type Wordlist struct {
+	name      string
+	separator string
+	words     []string
+	indexes   map[string]int
+}
+
+func mustLoadWordlist(name, separator string) *Wordlist {
+	data, err := wordlistFiles.ReadFile("wordlists/" + name + ".txt")
+	if err != nil {
+		panic(err)
+	}
+
+	words := strings.Split(strings.TrimSpace(string(data)), "\n")
+	if len(words) != wordlistLength {
+		panic("invalid wordlist length: " + name)
+	}
+
+	indexes := make(map[string]int, len(words))
+	for i, word := range words {
+		indexes[norm.NFKD.String(word)] = i
+	}
+
+	return &Wordlist{
+		name:      name,
+		separator: separator,
+		words:     words,
+		indexes:   indexes,
+	}
+}
+
+// Name of the wordlist language, for example `english`.
+func (w *Wordlist) Name() string {
+	return w.name
+}
+
+// Words returns a copy of the 2048 words in the wordlist.
+func (w *Wordlist) Words() []string {
+	return append([]string{}, w.words...)
+}
+
+// index returns the position of the NFKD normalized word in the wordlist.
+func (w *Wordlist) index(word string) (int, bool) {
+	i, ok := w.indexes[word]
+	return i, ok
+}
@@ -0,0 +1,226 @@
+package multikey
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/mailchain/go-crypto"
+	"github.com/mailchain/go-crypto/cipher"
+	"github.com/mailchain/go-crypto/cipher/ecdh"
+	"github.com/mailchain/go-crypto/ed25519"
+	"github.com/mailchain/go-crypto/secp256k1"
+	"github.com/mailchain/go-crypto/secp256r1"
+	"github.com/mailchain/go-crypto/sr25519"
+)
+
+func init() { //nolint: gochecknoinits
+	MustRegisterKeyKind(KeyKind{
+		Name:          crypto.KindSECP256K1,
+		ID:            crypto.IDSECP256K1,
+		PublicKeySize: 33,
+		PublicKeyFromBytes: func(data []byte) (crypto.PublicKey, error) {
+			return secp256k1.PublicKeyFromBytes(data)
+		},
+		PrivateKeyFromBytes: func(data []byte) (crypto.PrivateKey, error) {
+			return secp256k1.PrivateKeyFromBytes(data)
+		},
+		SeedSize: 32,
+		GenerateKey: func(rand io.Reader) (crypto.PrivateKey, error) {
+			return secp256k1.GenerateKey(rand)
+		},
+		IsPublicKey: func(key crypto.PublicKey) bool {
+			switch key.(type) {
+			case *secp256k1.PublicKey, secp256k1.PublicKey:
+				return true
+			default:
+				return false
+			}
+		},
+		IsPrivateKey: func(key crypto.PrivateKey) bool {
+			switch key.(type) {
+			case *secp256k1.PrivateKey, secp256k1.PrivateKey:
+				return true
+			default:
+				return false
+			}
+		},
+		SignatureIDs: []byte{
+			SignatureIDSECP256K1Recoverable, SignatureIDSECP256K1Schnorr, SignatureIDSECP256K1PersonalMessage,
+		},
+		VerifySignature: func(key crypto.PublicKey, algorithm byte, message, sig []byte) bool {
+			switch pk := key.(type) {
+			case *secp256k1.PublicKey:
+				return verifySECP256K1(*pk, algorithm, message, sig)
+			case secp256k1.PublicKey:
+				return verifySECP256K1(pk, algorithm, message, sig)
+			default:
+				return false
+			}
+		},
+		NewExtendedPrivateKey: func(seed []byte) (crypto.ExtendedPrivateKey, error) {
+			return secp256k1.NewExtendedPrivateKeyFromSeed(seed)
+		},
+		NewKeyExchange: func(rand io.Reader) (cipher.KeyExchange, error) {
+			return ecdh.NewSECP256K1(rand)
+		},
+	})
+
+	MustRegisterKeyKind(KeyKind{
+		Name:          crypto.KindED25519,
+		ID:            crypto.IDED25519,
+		PublicKeySize: ed25519.PublicKeySize,
+		PublicKeyFromBytes: func(data []byte) (crypto.PublicKey, error) {
+			return ed25519.PublicKeyFromBytes(data)
+		},
+		PrivateKeyFromBytes: func(data []byte) (crypto.PrivateKey, error) {
+			return ed25519.PrivateKeyFromBytes(data)
+		},
+		SeedSize: ed25519.SeedSize,
+		GenerateKey: func(rand io.Reader) (crypto.PrivateKey, error) {
+			return ed25519.GenerateKey(rand)
+		},
+		IsPublicKey: func(key crypto.PublicKey) bool {
+			switch key.(type) {
+			case *ed25519.PublicKey, ed25519.PublicKey:
+				return true
+			default:
+				return false
+			}
+		},
+		IsPrivateKey: func(key crypto.PrivateKey) bool {
+			switch key.(type) {
+			case *ed25519.PrivateKey, ed25519.PrivateKey:
+				return true
+			default:
+				return false
+			}
+		},
+		SignatureIDs: []byte{SignatureIDED25519},
+		NewExtendedPrivateKey: func(seed []byte) (crypto.ExtendedPrivateKey, error) {
+			return ed25519.NewExtendedPrivateKeyFromSeed(seed)
+		},
+		NewKeyExchange: func(rand io.Reader) (cipher.KeyExchange, error) {
+			return ecdh.NewED25519(rand)
+		},
+	})
+
+	MustRegisterKeyKind(KeyKind{
+		Name:          crypto.KindSR25519,
+		ID:            crypto.IDSR25519,
+		PublicKeySize: 32,
+		PublicKeyFromBytes: func(data []byte) (crypto.PublicKey, error) {
+			return sr25519.PublicKeyFromBytes(data)
+		},
+		PrivateKeyFromBytes: func(data []byte) (crypto.PrivateKey, error) {
+			return sr25519.PrivateKeyFromBytes(data)
+		},
+		SeedSize: 32,
+		GenerateKey: func(rand io.Reader) (crypto.PrivateKey, error) {
+			return sr25519.GenerateKey(rand)
+		},
+		IsPublicKey: func(key crypto.PublicKey) bool {
+			switch key.(type) {
+			case *sr25519.PublicKey, sr25519.PublicKey:
+				return true
+			default:
+				return false
+			}
+		},
+		IsPrivateKey: func(key crypto.PrivateKey) bool {
+			switch key.(type) {
+			case *sr25519.PrivateKey, sr25519.PrivateKey:
+				return true
+			default:
+				return false
+			}
+		},
+		SignatureIDs: []byte{SignatureIDSR25519Substrate},
+		NewExtendedPrivateKey: func(seed []byte) (crypto.ExtendedPrivateKey, error) {
+			key, err := sr25519.PrivateKeyFromBytes(seed)
+			if err != nil {
+				return nil, err
+			}
+
+			return sr25519.NewExtendedPrivateKey(key), nil
+		},
+		NewKeyExchange: func(rand io.Reader) (cipher.KeyExchange, error) {
+			return ecdh.NewSR25519(rand)
+		},
+	})
+
+	MustRegisterKeyKind(KeyKind{
+		Name:          crypto.KindSECP256R1,
+		ID:            crypto.IDSECP256R1,
+		PublicKeySize: 33,
+		PublicKeyFromBytes: func(data []byte) (crypto.PublicKey, error) {
+			return secp256r1.PublicKeyFromBytes(data)
+		},
+		PrivateKeyFromBytes: func(data []byte) (crypto.PrivateKey, error) {
+			return secp256r1.PrivateKeyFromBytes(data)
+		},
+		SeedSize: 32,
+		GenerateKey: func(rand io.Reader) (crypto.PrivateKey, error) {
+			return secp256r1.GenerateKey(rand)
+		},
+		IsPublicKey: func(key crypto.PublicKey) bool {
+			switch key.(type) {
+			case *secp256r1.PublicKey, secp256r1.PublicKey:
+				return true
+			default:
+				return false
+			}
+		},
+		IsPrivateKey: func(key crypto.PrivateKey) bool {
+			switch key.(type) {
+			case *secp256r1.PrivateKey, secp256r1.PrivateKey:
+				return true
+			default:
+				return false
+			}
+		},
+		SignatureIDs: []byte{SignatureIDSECP256R1, SignatureIDSECP256R1DER},
+		VerifySignature: func(key crypto.PublicKey, algorithm byte, message, sig []byte) bool {
+			switch pk := key.(type) {
+			case *secp256r1.PublicKey:
+				return verifySECP256R1(*pk, algorithm, message, sig)
+			case secp256r1.PublicKey:
+				return verifySECP256R1(pk, algorithm, message, sig)
+			default:
+				return false
+			}
+		},
+		NewExtendedPrivateKey: func(seed []byte) (crypto.ExtendedPrivateKey, error) {
+			return secp256r1.NewExtendedPrivateKeyFromSeed(seed)
+		},
+	})
+}
+
+func verifySECP256K1(pk secp256k1.PublicKey, algorithm byte, message, sig []byte) bool {
+	switch algorithm {
+	case SignatureIDSECP256K1Recoverable:
+		// the recovery ID is not covered by Verify, the signature must also recover this key.
+		recovered, err := secp256k1.RecoverPublicKey(message, sig)
+		if err != nil || !bytes.Equal(recovered.Bytes(), pk.Bytes()) {
+			return false
+		}
+
+		return pk.Verify(message, sig)
+	case SignatureIDSECP256K1Schnorr:
+		return pk.VerifySchnorr(message, sig)
+	case SignatureIDSECP256K1PersonalMessage:
+		return pk.VerifyPersonalMessage(message, sig)
+	default:
+		return false
+	}
+}
+
+func verifySECP256R1(pk secp256r1.PublicKey, algorithm byte, message, sig []byte) bool {
+	switch algorithm {
+	case SignatureIDSECP256R1:
+		return pk.Verify(message, sig)
+	case SignatureIDSECP256R1DER:
+		return pk.VerifyDER(message, sig)
+	default:
+		return false
+	}
+}
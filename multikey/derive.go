@@ -0,0 +1,39 @@
+package multikey
+
+import (
+	"fmt"
+
+	"github.com/mailchain/go-crypto"
+	"github.com/mailchain/go-crypto/hdpath"
+)
+
+// DerivePrivateKey derives the private key at path from seed.
+//
+// The derivation scheme is based on the key type, any registered key kind with NewExtendedPrivateKey is supported.
+// secp256k1 uses BIP-32, ed25519 and secp256r1 use SLIP-0010 where ed25519 only supports hardened paths.
+// sr25519 uses the 32 byte seed as the mini secret key then substrate hard and soft derivation,
+// hardened indexes are hard junctions and other indexes are soft junctions.
+func DerivePrivateKey(seed []byte, keyType string, path hdpath.Path) (crypto.PrivateKey, error) {
+	key, err := extendedPrivateKeyFromSeed(seed, keyType)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, index := range path {
+		key, err = key.Derive(index)
+		if err != nil {
+			return nil, fmt.Errorf("derive %s: %w", path, err)
+		}
+	}
+
+	return key.PrivateKey(), nil
+}
+
+func extendedPrivateKeyFromSeed(seed []byte, keyType string) (crypto.ExtendedPrivateKey, error) {
+	kind, ok := KeyKindByName(keyType)
+	if !ok || kind.NewExtendedPrivateKey == nil {
+		return nil, fmt.Errorf("unsupported key type: %q", keyType)
+	}
+
+	return kind.NewExtendedPrivateKey(seed)
+}
@@ -0,0 +1,111 @@
+package multikey
+
+import (
+	"testing"
+
+	"github.com/mailchain/go-crypto"
+	"github.com/mailchain/go-crypto/hdpath"
+	"github.com/mailchain/go-encoding/encodingtest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDerivePrivateKey(t *testing.T) {
+	vector1Seed := encodingtest.MustDecodeHex("000102030405060708090a0b0c0d0e0f")
+	devSeed := encodingtest.MustDecodeHex("fac7959dbfe72f052e5a0c3c8d6530f202b02fd8f9f5ca3580ec8deb7797479e")
+
+	tests := []struct {
+		name           string
+		seed           []byte
+		keyType        string
+		path           string
+		wantPrivateKey []byte
+		wantPublicKey  []byte
+		wantErr        bool
+	}{
+		{
+			"secp256k1-bip32-vector-1",
+			vector1Seed,
+			crypto.KindSECP256K1,
+			"m/0'/1/2'/2/1000000000",
+			encodingtest.MustDecodeHex("471b76e389e528d6de6d816857e012c5455051cad6660850e58372a6c3e6e7c8"),
+			nil,
+			false,
+		},
+		{
+			"ed25519-slip10-vector-1",
+			vector1Seed,
+			crypto.KindED25519,
+			"m/0'/1'/2'/2'/1000000000'",
+			nil,
+			encodingtest.MustDecodeHex("3c24da049451555d51a7014a37337aa4e12d41e485abccfa46b47dfb2af54b7a"),
+			false,
+		},
+		{
+			"secp256r1-slip10-vector-1",
+			vector1Seed,
+			crypto.KindSECP256R1,
+			"m/0'/1/2'/2/1000000000",
+			encodingtest.MustDecodeHex("21c4f269ef0a5fd1badf47eeacebeeaa3de22eb8e5b0adcd0f27dd99d34d0119"),
+			nil,
+			false,
+		},
+		{
+			"sr25519-master",
+			devSeed,
+			crypto.KindSR25519,
+			"m",
+			nil,
+			encodingtest.MustDecodeHex("46ebddef8cd9bb167dc30878d7113b7e168e6f0646beffd77d69d39bad76b47a"),
+			false,
+		},
+		{
+			"err-ed25519-non-hardened",
+			vector1Seed,
+			crypto.KindED25519,
+			"m/0'/1",
+			nil,
+			nil,
+			true,
+		},
+		{
+			"err-sr25519-seed-length",
+			vector1Seed,
+			crypto.KindSR25519,
+			"m/0'",
+			nil,
+			nil,
+			true,
+		},
+		{
+			"err-unknown-key-type",
+			vector1Seed,
+			"unknown",
+			"m/0'",
+			nil,
+			nil,
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path, err := hdpath.Parse(tt.path)
+			if !assert.NoError(t, err) {
+				return
+			}
+			got, err := DerivePrivateKey(tt.seed, tt.keyType, path)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("DerivePrivateKey() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if tt.wantPrivateKey != nil {
+				assert.Equal(t, tt.wantPrivateKey, got.Bytes())
+			}
+			if tt.wantPublicKey != nil {
+				assert.Equal(t, tt.wantPublicKey, got.PublicKey().Bytes())
+			}
+		})
+	}
+}
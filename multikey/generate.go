@@ -0,0 +1,71 @@
+package multikey
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/mailchain/go-crypto"
+)
+
+// maxSeedAttempts limits the number of seeds GenerateKey reads before giving up.
+// The chance of a random seed being unusable is below 2^-32 for all built in key kinds.
+const maxSeedAttempts = 16
+
+// PrivateKeyFromSeed creates the private key of keyType from seed.
+//
+// The seed is mapped to the private key based on the key type.
+// ed25519 uses the 32 byte RFC 8032 seed, sr25519 uses the 32 byte seed as the mini secret key,
+// secp256k1 and secp256r1 use the 32 byte seed as the private scalar.
+// crypto.ErrUnusableSeed is returned when the scalar is not valid for the curve, the caller must choose another seed.
+func PrivateKeyFromSeed(keyType string, seed []byte) (crypto.PrivateKey, error) {
+	kind, ok := KeyKindByName(keyType)
+	if !ok {
+		return nil, fmt.Errorf("unsupported key type: %q", keyType)
+	}
+
+	return privateKeyFromSeed(kind, seed)
+}
+
+// GenerateKey generates a private key of keyType using randomness from rand.
+//
+// A seed is read from rand and passed to PrivateKeyFromSeed, unusable seeds are rejected and another seed is read.
+// Key kinds without a seed size use the generator of the key kind.
+func GenerateKey(keyType string, rand io.Reader) (crypto.PrivateKey, error) {
+	kind, ok := KeyKindByName(keyType)
+	if !ok {
+		return nil, fmt.Errorf("unsupported key type: %q", keyType)
+	}
+
+	if kind.SeedSize == 0 {
+		return kind.GenerateKey(rand)
+	}
+
+	for i := 0; i < maxSeedAttempts; i++ {
+		seed := make([]byte, kind.SeedSize)
+		if _, err := io.ReadFull(rand, seed); err != nil {
+			return nil, err
+		}
+
+		key, err := privateKeyFromSeed(kind, seed)
+		if errors.Is(err, crypto.ErrUnusableSeed) {
+			continue
+		}
+
+		return key, err
+	}
+
+	return nil, fmt.Errorf("%s: no usable seed after %d attempts", keyType, maxSeedAttempts)
+}
+
+func privateKeyFromSeed(kind KeyKind, seed []byte) (crypto.PrivateKey, error) {
+	if kind.SeedSize == 0 {
+		return nil, fmt.Errorf("%s: private keys can not be created from a seed", kind.Name)
+	}
+
+	if len(seed) != kind.SeedSize {
+		return nil, fmt.Errorf("%s: seed must be %d bytes", kind.Name, kind.SeedSize)
+	}
+
+	return kind.PrivateKeyFromBytes(seed)
+}
@@ -0,0 +1,165 @@
+package multikey
+
+import (
+	"bytes"
+	"crypto/rand"
+	"testing"
+
+	"github.com/mailchain/go-crypto"
+	"github.com/mailchain/go-crypto/ed25519/ed25519test"
+	"github.com/mailchain/go-crypto/secp256k1/secp256k1test"
+	"github.com/mailchain/go-crypto/secp256r1/secp256r1test"
+	"github.com/mailchain/go-crypto/sr25519/sr25519test"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrivateKeyFromSeed(t *testing.T) {
+	tests := []struct {
+		name    string
+		keyType string
+		seed    []byte
+		want    crypto.PublicKey
+		wantErr error
+	}{
+		{
+			"secp256k1",
+			crypto.KindSECP256K1,
+			secp256k1test.AlicePrivateKey.Bytes(),
+			secp256k1test.AlicePublicKey,
+			nil,
+		},
+		{
+			"ed25519",
+			crypto.KindED25519,
+			ed25519test.AlicePrivateKey.Bytes()[:32],
+			ed25519test.AlicePublicKey,
+			nil,
+		},
+		{
+			"sr25519",
+			crypto.KindSR25519,
+			sr25519test.AlicePrivateKey.Bytes(),
+			sr25519test.AlicePublicKey,
+			nil,
+		},
+		{
+			"secp256r1",
+			crypto.KindSECP256R1,
+			secp256r1test.AlicePrivateKey.Bytes(),
+			secp256r1test.AlicePublicKey,
+			nil,
+		},
+		{
+			"err-secp256k1-zero",
+			crypto.KindSECP256K1,
+			make([]byte, 32),
+			nil,
+			crypto.ErrUnusableSeed,
+		},
+		{
+			"err-secp256k1-above-order",
+			crypto.KindSECP256K1,
+			bytes.Repeat([]byte{0xff}, 32),
+			nil,
+			crypto.ErrUnusableSeed,
+		},
+		{
+			"err-secp256r1-zero",
+			crypto.KindSECP256R1,
+			make([]byte, 32),
+			nil,
+			crypto.ErrUnusableSeed,
+		},
+		{
+			"err-secp256r1-above-order",
+			crypto.KindSECP256R1,
+			bytes.Repeat([]byte{0xff}, 32),
+			nil,
+			crypto.ErrUnusableSeed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := PrivateKeyFromSeed(tt.keyType, tt.seed)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, got)
+				return
+			}
+			if !assert.NoError(t, err) {
+				t.FailNow()
+			}
+			assert.Equal(t, tt.want.Bytes(), got.PublicKey().Bytes())
+		})
+	}
+}
+
+func TestPrivateKeyFromSeed_Errors(t *testing.T) {
+	mustRegisterTestKeyKind(t)
+
+	tests := []struct {
+		name    string
+		keyType string
+		seed    []byte
+	}{
+		{"unknown-key-type", "unknown", make([]byte, 32)},
+		{"ed25519-private-key", crypto.KindED25519, ed25519test.AlicePrivateKey.Bytes()},
+		{"sr25519-short", crypto.KindSR25519, make([]byte, 16)},
+		{"secp256k1-long", crypto.KindSECP256K1, make([]byte, 64)},
+		{"no-seed-size", testKindName, []byte{1, 2, 3, 4}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := PrivateKeyFromSeed(tt.keyType, tt.seed)
+			assert.Error(t, err)
+			assert.Nil(t, got)
+		})
+	}
+}
+
+func TestGenerateKey(t *testing.T) {
+	mustRegisterTestKeyKind(t)
+
+	for _, kind := range KeyKinds() {
+		t.Run(kind.Name, func(t *testing.T) {
+			got, err := GenerateKey(kind.Name, rand.Reader)
+			if !assert.NoError(t, err) {
+				t.FailNow()
+			}
+			assert.True(t, kind.IsPrivateKey(got))
+		})
+	}
+}
+
+func TestGenerateKey_RejectsUnusableSeed(t *testing.T) {
+	tests := []struct {
+		name    string
+		keyType string
+		key     crypto.PrivateKey
+	}{
+		{"secp256k1", crypto.KindSECP256K1, secp256k1test.AlicePrivateKey},
+		{"secp256r1", crypto.KindSECP256R1, secp256r1test.AlicePrivateKey},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := bytes.NewReader(append(append(make([]byte, 32), bytes.Repeat([]byte{0xff}, 32)...), tt.key.Bytes()...))
+
+			got, err := GenerateKey(tt.keyType, r)
+			if !assert.NoError(t, err) {
+				t.FailNow()
+			}
+			assert.Equal(t, tt.key.Bytes(), got.Bytes())
+		})
+	}
+}
+
+func TestGenerateKey_Errors(t *testing.T) {
+	_, err := GenerateKey("unknown", rand.Reader)
+	assert.Error(t, err)
+
+	_, err = GenerateKey(crypto.KindED25519, bytes.NewReader(make([]byte, 16)))
+	assert.Error(t, err)
+
+	_, err = GenerateKey(crypto.KindSECP256K1, bytes.NewReader(make([]byte, 32*maxSeedAttempts)))
+	assert.Error(t, err)
+}
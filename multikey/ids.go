@@ -1,41 +1,25 @@
 package multikey
 
 import (
-	"errors"
-
 	"github.com/mailchain/go-crypto"
-	"github.com/mailchain/go-crypto/ed25519"
-	"github.com/mailchain/go-crypto/secp256k1"
-	"github.com/mailchain/go-crypto/secp256r1"
-	"github.com/mailchain/go-crypto/sr25519"
 )
 
+// IDFromPublicKey returns the ID byte of the registered key kind of the public key.
 func IDFromPublicKey(key crypto.PublicKey) (byte, error) {
-	switch key.(type) {
-	case *ed25519.PublicKey, ed25519.PublicKey:
-		return crypto.IDED25519, nil
-	case *secp256k1.PublicKey, secp256k1.PublicKey:
-		return crypto.IDSECP256K1, nil
-	case *secp256r1.PublicKey, secp256r1.PublicKey:
-		return crypto.IDSECP256R1, nil
-	case *sr25519.PublicKey, sr25519.PublicKey:
-		return crypto.IDSR25519, nil
-	default:
-		return crypto.IDUnknown, errors.New("unknown public key type")
+	kind, err := KeyKindFromPublicKey(key)
+	if err != nil {
+		return crypto.IDUnknown, err
 	}
+
+	return kind.ID, nil
 }
 
+// IDFromPrivateKey returns the ID byte of the registered key kind of the private key.
 func IDFromPrivateKey(key crypto.PrivateKey) (byte, error) {
-	switch key.(type) {
-	case *ed25519.PrivateKey, ed25519.PrivateKey:
-		return crypto.IDED25519, nil
-	case *secp256k1.PrivateKey, secp256k1.PrivateKey:
-		return crypto.IDSECP256K1, nil
-	case *secp256r1.PrivateKey, secp256r1.PrivateKey:
-		return crypto.IDSECP256R1, nil
-	case *sr25519.PrivateKey, sr25519.PrivateKey:
-		return crypto.IDSR25519, nil
-	default:
-		return crypto.IDUnknown, errors.New("unknown private key type")
+	kind, err := KeyKindFromPrivateKey(key)
+	if err != nil {
+		return crypto.IDUnknown, err
 	}
+
+	return kind.ID, nil
 }
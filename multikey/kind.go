@@ -24,12 +24,12 @@ const (
 )
 
 // GetKeyKindFromBytes extracts the private key type from the publicKey and privateKey.
-// Supported private key types are defined in PossibleKeyKinds variable.
+// Supported private key types are the registered key kinds, see KeyKinds.
 func GetKeyKindFromBytes(publicKey, privateKey []byte) (crypto.PrivateKey, error) {
 	matches := make([]crypto.PrivateKey, 0, 1)
 
-	for keyKind := range crypto.KeyTypes() {
-		cPrivateKey, err := extractKeyTypeAndVerifyPrivateAndPublicKey(publicKey, privateKey, keyKind)
+	for _, keyKind := range KeyKinds() {
+		cPrivateKey, err := extractKeyTypeAndVerifyPrivateAndPublicKey(publicKey, privateKey, keyKind.Name)
 		if err != nil {
 			continue
 		}
@@ -1,34 +1,64 @@
 package multikey
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/mailchain/go-crypto"
-	"github.com/mailchain/go-crypto/ed25519"
-	"github.com/mailchain/go-crypto/secp256k1"
-	"github.com/mailchain/go-crypto/secp256r1"
-	"github.com/mailchain/go-crypto/sr25519"
+	"github.com/mailchain/go-encoding"
 )
 
 // PrivateKeyFromBytes returns a private key from `[]byte`.
 //
-// The function used to create the private key is based on the key type.
-// Supported key types are secp256k1, ed25519.
+// The function used to create the private key is based on the key type, any registered key kind is supported.
 func PrivateKeyFromBytes(keyType string, data []byte) (crypto.PrivateKey, error) {
-	switch keyType {
-	case crypto.KindSECP256K1:
-		return secp256k1.PrivateKeyFromBytes(data)
-	case crypto.KindED25519:
-		return ed25519.PrivateKeyFromBytes(data)
-	case crypto.KindSR25519:
-		return sr25519.PrivateKeyFromBytes(data)
-	case crypto.KindSECP256R1:
-		return secp256r1.PrivateKeyFromBytes(data)
-	default:
+	kind, ok := KeyKindByName(keyType)
+	if !ok {
 		return nil, fmt.Errorf("unsupported key type: %q", keyType)
 	}
+
+	return kind.PrivateKeyFromBytes(data)
+}
+
+// DescriptivePrivateKeyFromEncodedString decodes the string with the encoding, for example encoding.KindHex,
+// then returns the private key of the descriptive bytes, see DescriptivePrivateKeyFromBytes.
+func DescriptivePrivateKeyFromEncodedString(in string, encodedWith string) (crypto.PrivateKey, error) {
+	decodedBytes, err := encoding.Decode(encodedWith, in)
+	if err != nil {
+		return nil, err
+	}
+
+	return DescriptivePrivateKeyFromBytes(decodedBytes)
+}
+
+// DescriptivePrivateKeyFromBytes returns the private key of descriptive bytes as created by DescriptiveBytesFromPrivateKey.
+// The first byte identifies the key kind, the rest is passed to the private key decoder of the kind so any form it
+// accepts can be used, for example both the 32 byte seed and the 64 byte private key of ed25519.
+func DescriptivePrivateKeyFromBytes(in []byte) (crypto.PrivateKey, error) {
+	if len(in) <= 1 {
+		return nil, errors.New("input must contain id and private key")
+	}
+
+	kind, ok := KeyKindByID(in[0])
+	if !ok {
+		return nil, fmt.Errorf("first byte must identify key curve")
+	}
+
+	return kind.PrivateKeyFromBytes(in[1:]) // skip the id byte and return rest
+}
+
+// DescriptiveEncodedStringFromPrivateKey returns the descriptive bytes of the private key encoded with the encoding,
+// for example encoding.KindBase58. The result can be decoded with DescriptivePrivateKeyFromEncodedString.
+func DescriptiveEncodedStringFromPrivateKey(in crypto.PrivateKey, encodeWith string) (string, error) {
+	descriptiveBytes, err := DescriptiveBytesFromPrivateKey(in)
+	if err != nil {
+		return "", err
+	}
+
+	return encoding.Encode(encodeWith, descriptiveBytes)
 }
 
+// DescriptiveBytesFromPrivateKey prefixes the private key bytes with the ID of the key kind.
 func DescriptiveBytesFromPrivateKey(in crypto.PrivateKey) ([]byte, error) {
 	idByte, err := IDFromPrivateKey(in)
 	if err != nil {
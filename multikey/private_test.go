@@ -6,7 +6,9 @@ import (
 	"github.com/mailchain/go-crypto"
 	"github.com/mailchain/go-crypto/ed25519/ed25519test"
 	"github.com/mailchain/go-crypto/secp256k1/secp256k1test"
+	"github.com/mailchain/go-crypto/secp256r1/secp256r1test"
 	"github.com/mailchain/go-crypto/sr25519/sr25519test"
+	"github.com/mailchain/go-encoding"
 	"github.com/mailchain/go-encoding/encodingtest"
 	"github.com/stretchr/testify/assert"
 )
@@ -72,3 +74,134 @@ func TestPrivateKeyFromBytes(t *testing.T) {
 		})
 	}
 }
+
+func TestDescriptivePrivateKeyFromBytes(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      []byte
+		want    crypto.PrivateKey
+		wantErr bool
+	}{
+		{
+			"secp256k1",
+			append([]byte{crypto.IDSECP256K1}, secp256k1test.AlicePrivateKey.Bytes()...),
+			secp256k1test.AlicePrivateKey,
+			false,
+		},
+		{
+			"ed25519-private-key",
+			append([]byte{crypto.IDED25519}, ed25519test.AlicePrivateKey.Bytes()...),
+			ed25519test.AlicePrivateKey,
+			false,
+		},
+		{
+			"ed25519-seed",
+			append([]byte{crypto.IDED25519}, ed25519test.AlicePrivateKey.Bytes()[:32]...),
+			ed25519test.AlicePrivateKey,
+			false,
+		},
+		{
+			"sr25519",
+			append([]byte{crypto.IDSR25519}, sr25519test.AlicePrivateKey.Bytes()...),
+			sr25519test.AlicePrivateKey,
+			false,
+		},
+		{
+			"secp256r1",
+			append([]byte{crypto.IDSECP256R1}, secp256r1test.AlicePrivateKey.Bytes()...),
+			secp256r1test.AlicePrivateKey,
+			false,
+		},
+		{
+			"err-unknown-id",
+			append([]byte{0x00}, sr25519test.AlicePrivateKey.Bytes()...),
+			nil,
+			true,
+		},
+		{
+			"err-id-only",
+			[]byte{crypto.IDED25519},
+			nil,
+			true,
+		},
+		{
+			"err-ed25519-mismatched-public",
+			append(append([]byte{crypto.IDED25519}, ed25519test.AlicePrivateKey.Bytes()[:32]...), ed25519test.BobPublicKey.Bytes()...),
+			nil,
+			true,
+		},
+		{
+			"err-ed25519-length",
+			append([]byte{crypto.IDED25519}, ed25519test.AlicePrivateKey.Bytes()[:31]...),
+			nil,
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DescriptivePrivateKeyFromBytes(tt.in)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("DescriptivePrivateKeyFromBytes() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.want == nil {
+				assert.Nil(t, got)
+				return
+			}
+			assert.Equal(t, tt.want.Bytes(), got.Bytes())
+			assert.Equal(t, tt.want.PublicKey().Bytes(), got.PublicKey().Bytes())
+		})
+	}
+}
+
+func TestDescriptiveEncodedStringFromPrivateKey(t *testing.T) {
+	keys := []crypto.PrivateKey{
+		secp256k1test.AlicePrivateKey,
+		ed25519test.AlicePrivateKey,
+		sr25519test.AlicePrivateKey,
+		secp256r1test.AlicePrivateKey,
+	}
+	encodings := []string{encoding.KindHex, encoding.KindHex0XPrefix, encoding.KindBase58, encoding.KindBase64}
+
+	for _, key := range keys {
+		for _, encodeWith := range encodings {
+			kind, err := KindFromPrivateKey(key)
+			assert.NoError(t, err)
+
+			t.Run(kind+"-"+encodeWith, func(t *testing.T) {
+				encoded, err := DescriptiveEncodedStringFromPrivateKey(key, encodeWith)
+				if !assert.NoError(t, err) {
+					t.FailNow()
+				}
+
+				got, err := DescriptivePrivateKeyFromEncodedString(encoded, encodeWith)
+				if !assert.NoError(t, err) {
+					t.FailNow()
+				}
+				assert.Equal(t, key.Bytes(), got.Bytes())
+
+				gotKind, err := KindFromPrivateKey(got)
+				assert.NoError(t, err)
+				assert.Equal(t, kind, gotKind)
+			})
+		}
+	}
+}
+
+func TestDescriptivePrivateKeyFromEncodedString(t *testing.T) {
+	got, err := DescriptivePrivateKeyFromEncodedString(
+		encoding.EncodeHex(append([]byte{crypto.IDED25519}, ed25519test.AlicePrivateKey.Bytes()[:32]...)),
+		encoding.KindHex,
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, ed25519test.AlicePrivateKey.Bytes(), got.Bytes())
+
+	_, err = DescriptivePrivateKeyFromEncodedString("not hex", encoding.KindHex)
+	assert.Error(t, err)
+
+	_, err = DescriptivePrivateKeyFromEncodedString("00", "unknown")
+	assert.Error(t, err)
+
+	_, err = DescriptiveEncodedStringFromPrivateKey(ed25519test.AlicePrivateKey, "unknown")
+	assert.Error(t, err)
+}
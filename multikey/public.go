@@ -5,29 +5,21 @@ import (
 	"fmt"
 
 	"github.com/mailchain/go-crypto"
-	"github.com/mailchain/go-crypto/ed25519"
-	"github.com/mailchain/go-crypto/secp256k1"
-	"github.com/mailchain/go-crypto/secp256r1"
-	"github.com/mailchain/go-crypto/sr25519"
 	"github.com/mailchain/go-encoding"
 )
 
 // PublicKeyFromBytes use the correct function to get the private key from bytes
 func PublicKeyFromBytes(keyType string, data []byte) (crypto.PublicKey, error) {
-	switch keyType {
-	case crypto.KindSECP256K1:
-		return secp256k1.PublicKeyFromBytes(data)
-	case crypto.KindED25519:
-		return ed25519.PublicKeyFromBytes(data)
-	case crypto.KindSR25519:
-		return sr25519.PublicKeyFromBytes(data)
-	case crypto.KindSECP256R1:
-		return secp256r1.PublicKeyFromBytes(data)
-	default:
+	kind, ok := KeyKindByName(keyType)
+	if !ok {
 		return nil, fmt.Errorf("unsupported curve type")
 	}
+
+	return kind.PublicKeyFromBytes(data)
 }
 
+// DescriptivePublicKeyFromEncodedString decodes the string with the encoding, for example encoding.KindHex,
+// then returns the public key of the descriptive bytes, see DescriptivePublicKeyFromBytes.
 func DescriptivePublicKeyFromEncodedString(in string, encodedWith string) (crypto.PublicKey, error) {
 	decodedBytes, err := encoding.Decode(encodedWith, in)
 	if err != nil {
@@ -42,21 +34,23 @@ func DescriptivePublicKeyFromBytes(in []byte) (crypto.PublicKey, error) {
 		return nil, errors.New("input must contain id and public key")
 	}
 
-	keyType := in[0]
-	data := in[1:] // skip the id byte and return rest
+	kind, ok := KeyKindByID(in[0])
+	if !ok {
+		return nil, fmt.Errorf("first byte must identify key curve")
+	}
+
+	return kind.PublicKeyFromBytes(in[1:]) // skip the id byte and return rest
+}
 
-	switch keyType {
-	case crypto.IDSECP256K1:
-		return secp256k1.PublicKeyFromBytes(data)
-	case crypto.IDED25519:
-		return ed25519.PublicKeyFromBytes(data)
-	case crypto.IDSR25519:
-		return sr25519.PublicKeyFromBytes(data)
-	case crypto.IDSECP256R1:
-		return secp256r1.PublicKeyFromBytes(data)
-	default:
-		return nil, fmt.Errorf("first byte must identity key curve")
+// DescriptiveEncodedStringFromPublicKey returns the descriptive bytes of the public key encoded with the encoding,
+// for example encoding.KindBase58. The result can be decoded with DescriptivePublicKeyFromEncodedString.
+func DescriptiveEncodedStringFromPublicKey(in crypto.PublicKey, encodeWith string) (string, error) {
+	descriptiveBytes, err := DescriptiveBytesFromPublicKey(in)
+	if err != nil {
+		return "", err
 	}
+
+	return encoding.Encode(encodeWith, descriptiveBytes)
 }
 
 func DescriptiveBytesFromPublicKey(in crypto.PublicKey) ([]byte, error) {
@@ -6,7 +6,9 @@ import (
 	"github.com/mailchain/go-crypto"
 	"github.com/mailchain/go-crypto/ed25519/ed25519test"
 	"github.com/mailchain/go-crypto/secp256k1/secp256k1test"
+	"github.com/mailchain/go-crypto/secp256r1/secp256r1test"
 	"github.com/mailchain/go-crypto/sr25519/sr25519test"
+	"github.com/mailchain/go-encoding"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -194,3 +196,36 @@ func TestDescriptiveBytesFromPublicKey(t *testing.T) {
 		})
 	}
 }
+
+func TestDescriptiveEncodedStringFromPublicKey(t *testing.T) {
+	keys := []crypto.PublicKey{
+		secp256k1test.AlicePublicKey,
+		ed25519test.AlicePublicKey,
+		sr25519test.AlicePublicKey,
+		secp256r1test.AlicePublicKey,
+	}
+	encodings := []string{encoding.KindHex, encoding.KindHex0XPrefix, encoding.KindBase58, encoding.KindBase64}
+
+	for _, key := range keys {
+		for _, encodeWith := range encodings {
+			kind, err := KindFromPublicKey(key)
+			assert.NoError(t, err)
+
+			t.Run(kind+"-"+encodeWith, func(t *testing.T) {
+				encoded, err := DescriptiveEncodedStringFromPublicKey(key, encodeWith)
+				if !assert.NoError(t, err) {
+					t.FailNow()
+				}
+
+				got, err := DescriptivePublicKeyFromEncodedString(encoded, encodeWith)
+				if !assert.NoError(t, err) {
+					t.FailNow()
+				}
+				assert.Equal(t, key.Bytes(), got.Bytes())
+			})
+		}
+	}
+
+	_, err := DescriptiveEncodedStringFromPublicKey(nil, encoding.KindHex)
+	assert.Error(t, err)
+}
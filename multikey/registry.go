@@ -0,0 +1,179 @@
+package multikey
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+
+	"github.com/mailchain/go-crypto"
+	"github.com/mailchain/go-crypto/cipher"
+)
+
+// KeyKind describes a kind of key, multikey functions use the registered key kinds to identify, decode and generate keys.
+type KeyKind struct {
+	// Name of the key kind, for example secp256k1.
+	Name string
+	// ID is the byte identifier of the key kind used in descriptive encodings.
+	ID byte
+	// PublicKeySize is the size, in bytes, of the public key returned by Bytes.
+	PublicKeySize int
+	// PublicKeyFromBytes decodes a public key of this kind.
+	PublicKeyFromBytes func(data []byte) (crypto.PublicKey, error)
+	// PrivateKeyFromBytes decodes a private key of this kind.
+	// A seed of SeedSize bytes must be mapped to a private key, returning crypto.ErrUnusableSeed when
+	// the seed is outside of the valid range for the curve.
+	PrivateKeyFromBytes func(data []byte) (crypto.PrivateKey, error)
+	// SeedSize is the size, in bytes, of the seed used by PrivateKeyFromSeed and GenerateKey,
+	// 0 when private keys of this kind can not be created from a seed.
+	SeedSize int
+	// GenerateKey generates a private key of this kind using randomness from rand.
+	GenerateKey func(rand io.Reader) (crypto.PrivateKey, error)
+	// IsPublicKey returns true when the public key is of this kind.
+	IsPublicKey func(key crypto.PublicKey) bool
+	// IsPrivateKey returns true when the private key is of this kind.
+	IsPrivateKey func(key crypto.PrivateKey) bool
+	// NewKeyExchange returns the key exchange used to encrypt for keys of this kind,
+	// nil when the key kind does not support encryption.
+	NewKeyExchange func(rand io.Reader) (cipher.KeyExchange, error)
+	// SignatureIDs are the descriptive signature algorithm identifiers of this kind, the first is created by
+	// SignDescriptive. Empty when the key kind does not support descriptive signatures.
+	SignatureIDs []byte
+	// VerifySignature verifies a signature of the signature algorithm, which is one of SignatureIDs.
+	// When nil the Verify function of the public key is used for every algorithm.
+	VerifySignature func(key crypto.PublicKey, algorithm byte, message, sig []byte) bool
+	// NewExtendedPrivateKey creates the master extended private key from a seed, used by DerivePrivateKey.
+	// nil when the key kind does not support derivation.
+	NewExtendedPrivateKey func(seed []byte) (crypto.ExtendedPrivateKey, error)
+}
+
+var (
+	// ErrKeyKindRegistered is returned when a key kind with the same name, ID or signature ID is already registered.
+	ErrKeyKindRegistered = errors.New("key kind already registered")
+	// ErrInvalidKeyKind is returned when a key kind is missing its name or a required function.
+	ErrInvalidKeyKind = errors.New("key kind must have a name, decoders, generator and key matchers")
+)
+
+type keyKindRegistry struct {
+	mu            sync.RWMutex
+	byName        map[string]KeyKind
+	byID          map[byte]KeyKind
+	bySignatureID map[byte]KeyKind
+}
+
+var registry = &keyKindRegistry{ //nolint: gochecknoglobals
+	byName:        map[string]KeyKind{},
+	byID:          map[byte]KeyKind{},
+	bySignatureID: map[byte]KeyKind{},
+}
+
+// RegisterKeyKind registers the key kind so it is supported by the multikey functions.
+// Key kinds are usually registered in the init function of the package that implements them.
+func RegisterKeyKind(kind KeyKind) error {
+	if kind.Name == "" || kind.ID == crypto.IDUnknown || kind.PublicKeySize <= 0 ||
+		kind.PublicKeyFromBytes == nil || kind.PrivateKeyFromBytes == nil || kind.GenerateKey == nil ||
+		kind.IsPublicKey == nil || kind.IsPrivateKey == nil {
+		return ErrInvalidKeyKind
+	}
+
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+
+	if _, ok := registry.byName[kind.Name]; ok {
+		return fmt.Errorf("%w: %q", ErrKeyKindRegistered, kind.Name)
+	}
+
+	if _, ok := registry.byID[kind.ID]; ok {
+		return fmt.Errorf("%w: 0x%02x", ErrKeyKindRegistered, kind.ID)
+	}
+
+	for _, algorithm := range kind.SignatureIDs {
+		if _, ok := registry.bySignatureID[algorithm]; ok {
+			return fmt.Errorf("%w: signature 0x%02x", ErrKeyKindRegistered, algorithm)
+		}
+	}
+
+	registry.byName[kind.Name] = kind
+	registry.byID[kind.ID] = kind
+
+	for _, algorithm := range kind.SignatureIDs {
+		registry.bySignatureID[algorithm] = kind
+	}
+
+	return nil
+}
+
+// MustRegisterKeyKind registers the key kind and panics when it can not be registered, see RegisterKeyKind.
+func MustRegisterKeyKind(kind KeyKind) {
+	if err := RegisterKeyKind(kind); err != nil {
+		panic(err)
+	}
+}
+
+// KeyKinds returns the registered key kinds ordered by ID.
+func KeyKinds() []KeyKind {
+	registry.mu.RLock()
+	defer registry.mu.RUnlock()
+
+	kinds := make([]KeyKind, 0, len(registry.byID))
+	for _, kind := range registry.byID {
+		kinds = append(kinds, kind)
+	}
+
+	sort.Slice(kinds, func(i, j int) bool { return kinds[i].ID < kinds[j].ID })
+
+	return kinds
+}
+
+// KeyKindByName returns the registered key kind with the name.
+func KeyKindByName(name string) (KeyKind, bool) {
+	registry.mu.RLock()
+	defer registry.mu.RUnlock()
+
+	kind, ok := registry.byName[name]
+
+	return kind, ok
+}
+
+// KeyKindByID returns the registered key kind with the ID.
+func KeyKindByID(id byte) (KeyKind, bool) {
+	registry.mu.RLock()
+	defer registry.mu.RUnlock()
+
+	kind, ok := registry.byID[id]
+
+	return kind, ok
+}
+
+// KeyKindBySignatureID returns the registered key kind that creates signatures with the signature algorithm.
+func KeyKindBySignatureID(algorithm byte) (KeyKind, bool) {
+	registry.mu.RLock()
+	defer registry.mu.RUnlock()
+
+	kind, ok := registry.bySignatureID[algorithm]
+
+	return kind, ok
+}
+
+// KeyKindFromPublicKey returns the registered key kind of the public key.
+func KeyKindFromPublicKey(key crypto.PublicKey) (KeyKind, error) {
+	for _, kind := range KeyKinds() {
+		if kind.IsPublicKey(key) {
+			return kind, nil
+		}
+	}
+
+	return KeyKind{}, errors.New("unknown public key type")
+}
+
+// KeyKindFromPrivateKey returns the registered key kind of the private key.
+func KeyKindFromPrivateKey(key crypto.PrivateKey) (KeyKind, error) {
+	for _, kind := range KeyKinds() {
+		if kind.IsPrivateKey(key) {
+			return kind, nil
+		}
+	}
+
+	return KeyKind{}, errors.New("unknown private key type")
+}
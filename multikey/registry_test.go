@@ -0,0 +1,264 @@
+package multikey
+
+import (
+	"bytes"
+	"crypto/rand"
+	"errors"
+	"io"
+	"sync"
+	"testing"
+
+	"github.com/mailchain/go-crypto"
+	"github.com/mailchain/go-crypto/ed25519/ed25519test"
+	"github.com/mailchain/go-crypto/secp256k1/secp256k1test"
+	"github.com/mailchain/go-crypto/secp256r1/secp256r1test"
+	"github.com/mailchain/go-crypto/sr25519/sr25519test"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testKindName        = "test-kind"
+	testKindID          = 0xf0
+	testKindSignatureID = 0xf1
+)
+
+// testPublicKey and testPrivateKey are a key kind defined outside of the module, the public key is the private key.
+type testPublicKey struct{ key []byte }
+
+func (pk testPublicKey) Bytes() []byte { return pk.key }
+func (pk testPublicKey) Verify(message, sig []byte) bool {
+	return bytes.Equal(sig, append(append([]byte{}, pk.key...), message...))
+}
+
+type testPrivateKey struct{ key []byte }
+
+func (pk testPrivateKey) Bytes() []byte               { return pk.key }
+func (pk testPrivateKey) PublicKey() crypto.PublicKey { return testPublicKey(pk) }
+func (pk testPrivateKey) Sign(message []byte) ([]byte, error) {
+	return append(append([]byte{}, pk.key...), message...), nil
+}
+
+func testKeyFromBytes(data []byte) ([]byte, error) {
+	if len(data) != 4 {
+		return nil, errors.New("test key must be 4 bytes")
+	}
+
+	return data, nil
+}
+
+var registerTestKind sync.Once //nolint: gochecknoglobals
+
+func testKeyKind() KeyKind {
+	return KeyKind{
+		Name:          testKindName,
+		ID:            testKindID,
+		PublicKeySize: 4,
+		PublicKeyFromBytes: func(data []byte) (crypto.PublicKey, error) {
+			key, err := testKeyFromBytes(data)
+			if err != nil {
+				return nil, err
+			}
+
+			return testPublicKey{key}, nil
+		},
+		PrivateKeyFromBytes: func(data []byte) (crypto.PrivateKey, error) {
+			key, err := testKeyFromBytes(data)
+			if err != nil {
+				return nil, err
+			}
+
+			return testPrivateKey{key}, nil
+		},
+		GenerateKey: func(rand io.Reader) (crypto.PrivateKey, error) {
+			key := make([]byte, 4)
+			if _, err := io.ReadFull(rand, key); err != nil {
+				return nil, err
+			}
+
+			return testPrivateKey{key}, nil
+		},
+		IsPublicKey: func(key crypto.PublicKey) bool {
+			_, ok := key.(testPublicKey)
+			return ok
+		},
+		IsPrivateKey: func(key crypto.PrivateKey) bool {
+			_, ok := key.(testPrivateKey)
+			return ok
+		},
+		SignatureIDs: []byte{testKindSignatureID},
+	}
+}
+
+func mustRegisterTestKeyKind(t *testing.T) {
+	registerTestKind.Do(func() {
+		assert.NoError(t, RegisterKeyKind(testKeyKind()))
+	})
+}
+
+func TestRegisterKeyKind(t *testing.T) {
+	mustRegisterTestKeyKind(t)
+
+	privateKey := testPrivateKey{key: []byte{1, 2, 3, 4}}
+	publicKey := privateKey.PublicKey()
+
+	name, err := KindFromPublicKey(publicKey)
+	assert.NoError(t, err)
+	assert.Equal(t, testKindName, name)
+
+	name, err = KindFromPrivateKey(privateKey)
+	assert.NoError(t, err)
+	assert.Equal(t, testKindName, name)
+
+	id, err := IDFromPrivateKey(privateKey)
+	assert.NoError(t, err)
+	assert.Equal(t, byte(testKindID), id)
+
+	descriptive, err := DescriptiveBytesFromPublicKey(publicKey)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{testKindID, 1, 2, 3, 4}, descriptive)
+
+	got, err := DescriptivePublicKeyFromBytes(descriptive)
+	assert.NoError(t, err)
+	assert.Equal(t, publicKey, got)
+
+	got, err = PublicKeyFromBytes(testKindName, []byte{1, 2, 3, 4})
+	assert.NoError(t, err)
+	assert.Equal(t, publicKey, got)
+
+	gotPrivate, err := PrivateKeyFromBytes(testKindName, []byte{1, 2, 3, 4})
+	assert.NoError(t, err)
+	assert.Equal(t, privateKey, gotPrivate)
+
+	gotPrivate, err = GetKeyKindFromBytes([]byte{1, 2, 3, 4}, []byte{1, 2, 3, 4})
+	assert.NoError(t, err)
+	assert.Equal(t, privateKey, gotPrivate)
+
+	kind, ok := KeyKindByName(testKindName)
+	assert.True(t, ok)
+	generated, err := kind.GenerateKey(rand.Reader)
+	assert.NoError(t, err)
+	assert.Len(t, generated.Bytes(), 4)
+
+	sig, err := SignDescriptive(privateKey, []byte("message"))
+	assert.NoError(t, err)
+	assert.Equal(t, byte(testKindSignatureID), sig[0])
+
+	name, err = KindFromSignatureID(testKindSignatureID)
+	assert.NoError(t, err)
+	assert.Equal(t, testKindName, name)
+
+	ok, err = Verify(descriptive, []byte("message"), sig)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+
+	_, err = Verify(descriptive, []byte("message"), append([]byte{SignatureIDED25519}, sig[1:]...))
+	assert.ErrorIs(t, err, ErrSignatureKeyMismatch)
+
+	_, err = DerivePrivateKey(make([]byte, 32), testKindName, nil)
+	assert.Error(t, err)
+}
+
+func TestRegisterKeyKind_Errors(t *testing.T) {
+	mustRegisterTestKeyKind(t)
+
+	duplicateName := testKeyKind()
+	duplicateName.ID = 0xf1
+	assert.ErrorIs(t, RegisterKeyKind(duplicateName), ErrKeyKindRegistered)
+
+	duplicateID := testKeyKind()
+	duplicateID.Name = "other-test-kind"
+	assert.ErrorIs(t, RegisterKeyKind(duplicateID), ErrKeyKindRegistered)
+
+	duplicateSignatureID := testKeyKind()
+	duplicateSignatureID.Name = "other-test-kind"
+	duplicateSignatureID.ID = 0xf2
+	duplicateSignatureID.SignatureIDs = []byte{SignatureIDED25519}
+	assert.ErrorIs(t, RegisterKeyKind(duplicateSignatureID), ErrKeyKindRegistered)
+
+	duplicateBuiltIn := testKeyKind()
+	duplicateBuiltIn.Name = crypto.KindED25519
+	assert.ErrorIs(t, RegisterKeyKind(duplicateBuiltIn), ErrKeyKindRegistered)
+
+	missingDecoder := testKeyKind()
+	missingDecoder.Name = "missing-decoder"
+	missingDecoder.PublicKeyFromBytes = nil
+	assert.ErrorIs(t, RegisterKeyKind(missingDecoder), ErrInvalidKeyKind)
+
+	assert.ErrorIs(t, RegisterKeyKind(KeyKind{}), ErrInvalidKeyKind)
+	assert.Panics(t, func() { MustRegisterKeyKind(KeyKind{}) })
+
+	_, ok := KeyKindByName("other-test-kind")
+	assert.False(t, ok)
+}
+
+func TestKeyKinds(t *testing.T) {
+	kinds := KeyKinds()
+
+	ids := make([]byte, 0, len(kinds))
+	for _, kind := range kinds {
+		ids = append(ids, kind.ID)
+	}
+
+	assert.Subset(t, ids, []byte{crypto.IDSECP256K1, crypto.IDED25519, crypto.IDSR25519, crypto.IDSECP256R1})
+	assert.IsIncreasing(t, ids)
+
+	for name, id := range crypto.CurveKindIDMapping {
+		kind, ok := KeyKindByID(id)
+		assert.True(t, ok)
+		assert.Equal(t, name, kind.Name)
+	}
+}
+
+func TestKeyKind_BuiltIn(t *testing.T) {
+	tests := []struct {
+		name           string
+		privateKey     crypto.PrivateKey
+		publicKey      crypto.PublicKey
+		hasKeyExchange bool
+	}{
+		{
+			crypto.KindSECP256K1,
+			secp256k1test.AlicePrivateKey,
+			secp256k1test.AlicePublicKey,
+			true,
+		},
+		{
+			crypto.KindED25519,
+			ed25519test.AlicePrivateKey,
+			ed25519test.AlicePublicKey,
+			true,
+		},
+		{
+			crypto.KindSR25519,
+			sr25519test.AlicePrivateKey,
+			sr25519test.AlicePublicKey,
+			true,
+		},
+		{
+			crypto.KindSECP256R1,
+			secp256r1test.AlicePrivateKey,
+			secp256r1test.AlicePublicKey,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			kind, err := KeyKindFromPublicKey(tt.publicKey)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.name, kind.Name)
+			assert.Len(t, tt.publicKey.Bytes(), kind.PublicKeySize)
+			assert.Equal(t, tt.hasKeyExchange, kind.NewKeyExchange != nil)
+			assert.NotEmpty(t, kind.SignatureIDs)
+			assert.NotNil(t, kind.NewExtendedPrivateKey)
+
+			kind, err = KeyKindFromPrivateKey(tt.privateKey)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.name, kind.Name)
+
+			generated, err := kind.GenerateKey(rand.Reader)
+			assert.NoError(t, err)
+			assert.True(t, kind.IsPrivateKey(generated))
+			assert.True(t, kind.IsPublicKey(generated.PublicKey()))
+		})
+	}
+}
@@ -0,0 +1,134 @@
+package multikey
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/mailchain/go-crypto"
+)
+
+// Signature algorithm identifiers of the built in key kinds, the first byte of a descriptive signature.
+// The high nibble matches the key kind, 1 for secp256k1, 2 for ed25519, 3 for sr25519 and 4 for secp256r1.
+// Registered key kinds define their own, see KeyKind.SignatureIDs.
+const (
+	// SignatureIDSECP256K1Recoverable 65 byte r || s || v ECDSA signature of a 32 byte hash as created by secp256k1 Sign,
+	// v is the recovery ID, 0 or 1 or the Ethereum 27 or 28 form.
+	SignatureIDSECP256K1Recoverable = 0x11
+	// SignatureIDSECP256K1Schnorr 64 byte BIP-340 Schnorr signature of a 32 byte hash.
+	SignatureIDSECP256K1Schnorr = 0x12
+	// SignatureIDSECP256K1PersonalMessage 65 byte EIP-191 personal_sign signature of the message.
+	SignatureIDSECP256K1PersonalMessage = 0x13
+	// SignatureIDED25519 64 byte ed25519 signature of the message.
+	SignatureIDED25519 = 0x21
+	// SignatureIDSR25519Substrate 64 byte sr25519 signature of the message with the `substrate` signing context.
+	SignatureIDSR25519Substrate = 0x31
+	// SignatureIDSECP256R1 64 byte r || s ECDSA signature of a 32 byte SHA-256 digest as created by secp256r1 Sign.
+	SignatureIDSECP256R1 = 0x41
+	// SignatureIDSECP256R1DER ASN.1 DER encoded ECDSA signature of a 32 byte SHA-256 digest.
+	SignatureIDSECP256R1DER = 0x42
+)
+
+var (
+	// ErrUnknownSignatureAlgorithm is returned when the first byte of a descriptive signature is not a known signature algorithm.
+	ErrUnknownSignatureAlgorithm = errors.New("unknown signature algorithm")
+	// ErrSignatureKeyMismatch is returned when the signature algorithm can not be used with the key kind.
+	ErrSignatureKeyMismatch = errors.New("signature algorithm does not match key kind")
+)
+
+// KindFromSignatureID returns the key kind that creates signatures with the signature algorithm.
+func KindFromSignatureID(algorithm byte) (string, error) {
+	kind, ok := KeyKindBySignatureID(algorithm)
+	if !ok {
+		return "", fmt.Errorf("%w: 0x%02x", ErrUnknownSignatureAlgorithm, algorithm)
+	}
+
+	return kind.Name, nil
+}
+
+// DescriptiveBytesFromSignature prefixes the signature with the signature algorithm identifier.
+func DescriptiveBytesFromSignature(algorithm byte, sig []byte) ([]byte, error) {
+	if _, err := KindFromSignatureID(algorithm); err != nil {
+		return nil, err
+	}
+
+	out := make([]byte, len(sig)+1)
+	out[0] = algorithm
+	copy(out[1:], sig)
+
+	return out, nil
+}
+
+// DescriptiveSignatureFromBytes returns the signature algorithm identifier and the signature of a descriptive signature.
+func DescriptiveSignatureFromBytes(in []byte) (algorithm byte, sig []byte, err error) {
+	if len(in) <= 1 {
+		return 0, nil, errors.New("input must contain id and signature")
+	}
+
+	if _, err := KindFromSignatureID(in[0]); err != nil {
+		return 0, nil, err
+	}
+
+	return in[0], in[1:], nil
+}
+
+// SignDescriptive signs the message with the private key and returns a descriptive signature.
+// The signature algorithm is the first of the key kind SignatureIDs, secp256k1 keys create
+// SignatureIDSECP256K1Recoverable, secp256r1 keys SignatureIDSECP256R1, ed25519 keys SignatureIDED25519 and
+// sr25519 keys SignatureIDSR25519Substrate signatures.
+func SignDescriptive(key crypto.PrivateKey, message []byte) ([]byte, error) {
+	kind, err := KeyKindFromPrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(kind.SignatureIDs) == 0 {
+		return nil, fmt.Errorf("%s keys do not support descriptive signatures", kind.Name)
+	}
+
+	sig, err := key.Sign(message)
+	if err != nil {
+		return nil, err
+	}
+
+	return DescriptiveBytesFromSignature(kind.SignatureIDs[0], sig)
+}
+
+// Verify verifies whether the descriptive signature is a valid signature of message by the descriptive public key.
+// The signature algorithm is read from the signature, an error is returned when the key or signature can not be
+// decoded or the signature algorithm does not match the key kind.
+func Verify(descriptiveKey, message, descriptiveSig []byte) (bool, error) {
+	key, err := DescriptivePublicKeyFromBytes(descriptiveKey)
+	if err != nil {
+		return false, err
+	}
+
+	algorithm, sig, err := DescriptiveSignatureFromBytes(descriptiveSig)
+	if err != nil {
+		return false, err
+	}
+
+	return VerifySignature(key, algorithm, message, sig)
+}
+
+// VerifySignature verifies whether sig is a valid signature of message by the public key using the signature algorithm.
+func VerifySignature(key crypto.PublicKey, algorithm byte, message, sig []byte) (bool, error) {
+	signatureKind, ok := KeyKindBySignatureID(algorithm)
+	if !ok {
+		return false, fmt.Errorf("%w: 0x%02x", ErrUnknownSignatureAlgorithm, algorithm)
+	}
+
+	keyKind, err := KeyKindFromPublicKey(key)
+	if err != nil {
+		return false, err
+	}
+
+	if signatureKind.Name != keyKind.Name {
+		return false, fmt.Errorf("%w: %s key with 0x%02x signature", ErrSignatureKeyMismatch, keyKind.Name, algorithm)
+	}
+
+	if keyKind.VerifySignature == nil {
+		return key.Verify(message, sig), nil
+	}
+
+	return keyKind.VerifySignature(key, algorithm, message, sig), nil
+}
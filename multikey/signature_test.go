@@ -0,0 +1,318 @@
+package multikey
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"github.com/mailchain/go-crypto"
+	"github.com/mailchain/go-crypto/ed25519/ed25519test"
+	"github.com/mailchain/go-crypto/secp256k1"
+	"github.com/mailchain/go-crypto/secp256k1/secp256k1test"
+	"github.com/mailchain/go-crypto/secp256r1"
+	"github.com/mailchain/go-crypto/secp256r1/secp256r1test"
+	"github.com/mailchain/go-crypto/sr25519/sr25519test"
+	"github.com/stretchr/testify/assert"
+)
+
+func mustDescriptiveBytesFromPublicKey(key crypto.PublicKey) []byte {
+	out, err := DescriptiveBytesFromPublicKey(key)
+	if err != nil {
+		panic(err)
+	}
+
+	return out
+}
+
+func TestSignDescriptiveVerify(t *testing.T) {
+	digest := sha256.Sum256([]byte("message"))
+
+	tests := []struct {
+		name       string
+		privateKey crypto.PrivateKey
+		publicKey  crypto.PublicKey
+		otherKey   crypto.PublicKey
+		wantID     byte
+	}{
+		{
+			"secp256k1",
+			secp256k1test.AlicePrivateKey,
+			secp256k1test.AlicePublicKey,
+			secp256k1test.BobPublicKey,
+			SignatureIDSECP256K1Recoverable,
+		},
+		{
+			"ed25519",
+			ed25519test.AlicePrivateKey,
+			ed25519test.AlicePublicKey,
+			ed25519test.BobPublicKey,
+			SignatureIDED25519,
+		},
+		{
+			"sr25519",
+			sr25519test.AlicePrivateKey,
+			sr25519test.AlicePublicKey,
+			sr25519test.BobPublicKey,
+			SignatureIDSR25519Substrate,
+		},
+		{
+			"secp256r1",
+			secp256r1test.AlicePrivateKey,
+			secp256r1test.AlicePublicKey,
+			secp256r1test.BobPublicKey,
+			SignatureIDSECP256R1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sig, err := SignDescriptive(tt.privateKey, digest[:])
+			if !assert.NoError(t, err) {
+				t.FailNow()
+			}
+			assert.Equal(t, tt.wantID, sig[0])
+
+			got, err := Verify(mustDescriptiveBytesFromPublicKey(tt.publicKey), digest[:], sig)
+			assert.NoError(t, err)
+			assert.True(t, got)
+
+			got, err = Verify(mustDescriptiveBytesFromPublicKey(tt.otherKey), digest[:], sig)
+			assert.NoError(t, err)
+			assert.False(t, got)
+
+			got, err = Verify(mustDescriptiveBytesFromPublicKey(tt.publicKey), make([]byte, 32), sig)
+			assert.NoError(t, err)
+			assert.False(t, got)
+		})
+	}
+}
+
+func TestVerify_Variants(t *testing.T) {
+	message := []byte("message")
+	digest := sha256.Sum256(message)
+
+	k1Key := secp256k1test.AlicePrivateKey.(*secp256k1.PrivateKey)
+	r1Key := secp256r1test.AlicePrivateKey.(*secp256r1.PrivateKey)
+
+	schnorrSig, err := k1Key.SignSchnorr(digest[:])
+	assert.NoError(t, err)
+	personalSig, err := k1Key.SignPersonalMessage(message)
+	assert.NoError(t, err)
+	r1Sig, err := r1Key.Sign(digest[:])
+	assert.NoError(t, err)
+	r1DER, err := secp256r1.SignatureToDER(r1Sig)
+	assert.NoError(t, err)
+
+	recoverableSig, err := k1Key.Sign(digest[:])
+	assert.NoError(t, err)
+	withRecoveryID := func(v byte) []byte {
+		sig := append([]byte{}, recoverableSig...)
+		sig[64] = v
+
+		return sig
+	}
+
+	tests := []struct {
+		name      string
+		publicKey crypto.PublicKey
+		message   []byte
+		algorithm byte
+		sig       []byte
+		want      bool
+	}{
+		{
+			"secp256k1-recoverable-ethereum-v",
+			secp256k1test.AlicePublicKey,
+			digest[:],
+			SignatureIDSECP256K1Recoverable,
+			withRecoveryID(recoverableSig[64] + 27),
+			true,
+		},
+		{
+			"secp256k1-recoverable-other-recovery-id",
+			secp256k1test.AlicePublicKey,
+			digest[:],
+			SignatureIDSECP256K1Recoverable,
+			withRecoveryID(recoverableSig[64] ^ 1),
+			false,
+		},
+		{
+			"secp256k1-recoverable-invalid-v",
+			secp256k1test.AlicePublicKey,
+			digest[:],
+			SignatureIDSECP256K1Recoverable,
+			withRecoveryID(recoverableSig[64] + 2),
+			false,
+		},
+		{
+			"secp256k1-recoverable-v-0xff",
+			secp256k1test.AlicePublicKey,
+			digest[:],
+			SignatureIDSECP256K1Recoverable,
+			withRecoveryID(0xff),
+			false,
+		},
+		{
+			"secp256k1-schnorr",
+			secp256k1test.AlicePublicKey,
+			digest[:],
+			SignatureIDSECP256K1Schnorr,
+			schnorrSig,
+			true,
+		},
+		{
+			"secp256k1-schnorr-wrong-key",
+			secp256k1test.BobPublicKey,
+			digest[:],
+			SignatureIDSECP256K1Schnorr,
+			schnorrSig,
+			false,
+		},
+		{
+			"secp256k1-personal-message",
+			secp256k1test.AlicePublicKey,
+			message,
+			SignatureIDSECP256K1PersonalMessage,
+			personalSig,
+			true,
+		},
+		{
+			"secp256k1-personal-message-as-recoverable",
+			secp256k1test.AlicePublicKey,
+			message,
+			SignatureIDSECP256K1Recoverable,
+			personalSig,
+			false,
+		},
+		{
+			"secp256k1-recoverable-without-recovery-id",
+			secp256k1test.AlicePublicKey,
+			digest[:],
+			SignatureIDSECP256K1Recoverable,
+			schnorrSig,
+			false,
+		},
+		{
+			"secp256r1-der",
+			secp256r1test.AlicePublicKey,
+			digest[:],
+			SignatureIDSECP256R1DER,
+			r1DER,
+			true,
+		},
+		{
+			"secp256r1-der-as-fixed",
+			secp256r1test.AlicePublicKey,
+			digest[:],
+			SignatureIDSECP256R1,
+			r1DER,
+			false,
+		},
+		{
+			"secp256r1-fixed-as-der",
+			secp256r1test.AlicePublicKey,
+			digest[:],
+			SignatureIDSECP256R1DER,
+			r1Sig,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sig, err := DescriptiveBytesFromSignature(tt.algorithm, tt.sig)
+			if !assert.NoError(t, err) {
+				t.FailNow()
+			}
+
+			got, err := Verify(mustDescriptiveBytesFromPublicKey(tt.publicKey), tt.message, sig)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestVerify_Errors(t *testing.T) {
+	digest := sha256.Sum256([]byte("message"))
+
+	edSig, err := SignDescriptive(ed25519test.AlicePrivateKey, digest[:])
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	tests := []struct {
+		name    string
+		key     []byte
+		sig     []byte
+		wantErr error
+	}{
+		{
+			"key-mismatch",
+			mustDescriptiveBytesFromPublicKey(sr25519test.AlicePublicKey),
+			edSig,
+			ErrSignatureKeyMismatch,
+		},
+		{
+			"unknown-algorithm",
+			mustDescriptiveBytesFromPublicKey(ed25519test.AlicePublicKey),
+			append([]byte{0xff}, edSig[1:]...),
+			ErrUnknownSignatureAlgorithm,
+		},
+		{
+			"empty-signature",
+			mustDescriptiveBytesFromPublicKey(ed25519test.AlicePublicKey),
+			edSig[:1],
+			nil,
+		},
+		{
+			"invalid-key",
+			[]byte{0xff, 0x01},
+			edSig,
+			nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Verify(tt.key, digest[:], tt.sig)
+			assert.Error(t, err)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			}
+			assert.False(t, got)
+		})
+	}
+}
+
+func TestDescriptiveSignatureFromBytes(t *testing.T) {
+	in, err := DescriptiveBytesFromSignature(SignatureIDED25519, []byte{0x01, 0x02})
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{SignatureIDED25519, 0x01, 0x02}, in)
+
+	algorithm, sig, err := DescriptiveSignatureFromBytes(in)
+	assert.NoError(t, err)
+	assert.Equal(t, byte(SignatureIDED25519), algorithm)
+	assert.Equal(t, []byte{0x01, 0x02}, sig)
+
+	_, err = DescriptiveBytesFromSignature(0x00, []byte{0x01})
+	assert.ErrorIs(t, err, ErrUnknownSignatureAlgorithm)
+
+	_, _, err = DescriptiveSignatureFromBytes(nil)
+	assert.Error(t, err)
+}
+
+func TestKindFromSignatureID(t *testing.T) {
+	tests := []struct {
+		algorithm byte
+		want      string
+	}{
+		{SignatureIDSECP256K1Recoverable, crypto.KindSECP256K1},
+		{SignatureIDSECP256K1Schnorr, crypto.KindSECP256K1},
+		{SignatureIDSECP256K1PersonalMessage, crypto.KindSECP256K1},
+		{SignatureIDED25519, crypto.KindED25519},
+		{SignatureIDSR25519Substrate, crypto.KindSR25519},
+		{SignatureIDSECP256R1, crypto.KindSECP256R1},
+		{SignatureIDSECP256R1DER, crypto.KindSECP256R1},
+	}
+	for _, tt := range tests {
+		got, err := KindFromSignatureID(tt.algorithm)
+		assert.NoError(t, err)
+		assert.Equal(t, tt.want, got)
+	}
+}
@@ -1,6 +1,12 @@
 //go:generate mockgen -source=private.go -package=cryptotest -destination=./cryptotest/private_mock.go
 package crypto
 
+import "errors"
+
+// ErrUnusableSeed is returned when a seed maps to a private key outside of the valid range for the curve,
+// the caller must choose another seed.
+var ErrUnusableSeed = errors.New("unusable seed")
+
 // PrivateKey definition usable in all mailchain crypto operations
 type PrivateKey interface {
 	// Bytes returns the byte representation of the private key
@@ -16,3 +16,22 @@ type ExtendedPublicKey interface {
 	PublicKey() PublicKey
 	Derive(index uint32) (ExtendedPublicKey, error)
 }
+
+// StrictVerifier is implemented by public keys that support strict signature verification.
+type StrictVerifier interface {
+	// VerifyStrict verifies whether sig is a valid signature of message, rejecting malleable signatures,
+	// non canonical encodings and signatures of invalid lengths.
+	VerifyStrict(message, sig []byte) bool
+}
+
+// VerifyStrict verifies whether sig is a valid signature of message by the public key using strict verification,
+// it is the recommended verification where every party must agree on which signatures are valid, for example consensus.
+// Public keys that do not implement StrictVerifier are never valid.
+func VerifyStrict(pk PublicKey, message, sig []byte) bool {
+	verifier, ok := pk.(StrictVerifier)
+	if !ok {
+		return false
+	}
+
+	return verifier.VerifyStrict(message, sig)
+}
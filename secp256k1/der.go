@@ -0,0 +1,55 @@
+package secp256k1
+
+import (
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/mailchain/go-crypto/internal/ecdsasig"
+)
+
+// SignatureSize is the size, in bytes, of the fixed width r || s signature without the recovery ID.
+const SignatureSize = 64
+
+var (
+	// ErrInvalidDER is returned when a signature is not a strict DER encoded ECDSA signature.
+	ErrInvalidDER = ecdsasig.ErrInvalidDER
+	// ErrInvalidFixedSignatureLength is returned when a fixed width signature is not 64 or 65 bytes.
+	ErrInvalidFixedSignatureLength = ecdsasig.ErrInvalidFixed
+	// ErrSignatureOutOfRange is returned when r or s is not between 1 and the curve order - 1.
+	ErrSignatureOutOfRange = ecdsasig.ErrOutOfRange
+)
+
+// SignatureToDER converts the 64 byte r || s signature, or the 65 byte signature with recovery ID returned by Sign, to ASN.1 DER.
+// The recovery ID is not part of the DER encoding.
+func SignatureToDER(sig []byte) ([]byte, error) {
+	switch len(sig) {
+	case SignatureSize, RecoverableSignatureSize:
+		return ecdsasig.FixedToDER(sig[:SignatureSize], ethcrypto.S256().Params().N)
+	default:
+		return nil, ErrInvalidFixedSignatureLength
+	}
+}
+
+// SignatureFromDER converts the strict ASN.1 DER encoded signature to the 64 byte r || s form accepted by Verify.
+func SignatureFromDER(der []byte) ([]byte, error) {
+	return ecdsasig.DERToFixed(der, ethcrypto.S256().Params().N)
+}
+
+// VerifyDER verifies whether the strict ASN.1 DER encoded sig is a valid signature of message.
+// As with Verify, signatures with a high S value are rejected.
+func (pk PublicKey) VerifyDER(message, der []byte) bool {
+	sig, err := SignatureFromDER(der)
+	if err != nil {
+		return false
+	}
+
+	return pk.Verify(message, sig)
+}
+
+// VerifyAnyFormat verifies whether sig is a valid signature of message where sig is either the 64 byte r || s form,
+// the 65 byte form with recovery ID or strict ASN.1 DER.
+func (pk PublicKey) VerifyAnyFormat(message, sig []byte) bool {
+	if (len(sig) == SignatureSize || len(sig) == RecoverableSignatureSize) && pk.Verify(message, sig) {
+		return true
+	}
+
+	return pk.VerifyDER(message, sig)
+}
@@ -0,0 +1,55 @@
+package secp256k1
+
+import (
+	"crypto/sha256"
+	"math/big"
+	"testing"
+
+	btcecdsa "github.com/btcsuite/btcd/btcec/v2/ecdsa"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSignatureDER(t *testing.T) {
+	digest := sha256.Sum256([]byte("message"))
+
+	sig, err := bobPrivateKey.Sign(digest[:])
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	der, err := SignatureToDER(sig)
+	assert.NoError(t, err)
+
+	parsed, err := btcecdsa.ParseDERSignature(der)
+	assert.NoError(t, err)
+	assert.Equal(t, der, parsed.Serialize())
+
+	withoutRecoveryID, err := SignatureToDER(sig[:64])
+	assert.NoError(t, err)
+	assert.Equal(t, der, withoutRecoveryID)
+
+	assert.True(t, bobPublicKey.VerifyDER(digest[:], der))
+	assert.True(t, bobPublicKey.VerifyAnyFormat(digest[:], der))
+	assert.True(t, bobPublicKey.VerifyAnyFormat(digest[:], sig))
+	assert.True(t, bobPublicKey.VerifyAnyFormat(digest[:], sig[:64]))
+	assert.False(t, alicePublicKey.VerifyDER(digest[:], der))
+	assert.False(t, bobPublicKey.VerifyDER(digest[:], sig))
+
+	fixed, err := SignatureFromDER(der)
+	assert.NoError(t, err)
+	assert.Equal(t, sig[:64], fixed)
+
+	// high S signatures are rejected the same as Verify
+	n := new(big.Int).Set(ethcrypto.S256().Params().N)
+	highS := append(append([]byte{}, fixed[:32]...), n.Sub(n, new(big.Int).SetBytes(fixed[32:])).FillBytes(make([]byte, 32))...)
+	highSDER, err := SignatureToDER(highS)
+	assert.NoError(t, err)
+	assert.False(t, bobPublicKey.VerifyDER(digest[:], highSDER))
+
+	_, err = SignatureFromDER(append(append([]byte{}, der...), 0x00))
+	assert.ErrorIs(t, err, ErrInvalidDER)
+
+	_, err = SignatureToDER(sig[:63])
+	assert.ErrorIs(t, err, ErrInvalidFixedSignatureLength)
+}
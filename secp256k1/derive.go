@@ -0,0 +1,37 @@
+package secp256k1
+
+import (
+	"errors"
+
+	"github.com/mailchain/go-crypto"
+	"github.com/minio/blake2b-simd"
+)
+
+var hdkd = []byte{52, 83, 101, 99, 112, 50, 53, 54, 107, 49, 72, 68, 75, 68} //Secp256k1HDKD prefix compatible with polkadot ecdsa HDKD
+
+// DeriveHardenedKey derives a child private key using substrate ecdsa hard derivation, `//junction` in substrate.
+// The chaincode is the junction as created by the chaincode package.
+func DeriveHardenedKey(parent crypto.PrivateKey, chaincode []byte) (*PrivateKey, error) {
+	parentSeed, err := seedBytes(parent)
+	if err != nil {
+		return nil, err
+	}
+
+	val := append(append([]byte{}, hdkd...), parentSeed...)
+	val = append(val, chaincode...)
+
+	childSeed := blake2b.Sum256(val)
+
+	return PrivateKeyFromBytes(childSeed[:])
+}
+
+func seedBytes(parent crypto.PrivateKey) ([]byte, error) {
+	switch ecKey := parent.(type) {
+	case *PrivateKey:
+		return ecKey.Bytes(), nil
+	case PrivateKey:
+		return ecKey.Bytes(), nil
+	default:
+		return nil, errors.New("unknown private key type")
+	}
+}
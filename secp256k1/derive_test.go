@@ -0,0 +1,23 @@
+package secp256k1
+
+import (
+	"testing"
+
+	"github.com/mailchain/go-crypto/chaincode"
+	"github.com/mailchain/go-encoding/encodingtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeriveHardenedKey(t *testing.T) {
+	// substrate development seed, `subkey inspect --scheme ecdsa //Alice`
+	root, err := PrivateKeyFromBytes(encodingtest.MustDecodeHex("fac7959dbfe72f052e5a0c3c8d6530f202b02fd8f9f5ca3580ec8deb7797479e"))
+	require.NoError(t, err)
+
+	got, err := DeriveHardenedKey(root, chaincode.ChainCodeFromDeriveIndexString("Alice"))
+	require.NoError(t, err)
+	assert.Equal(t, encodingtest.MustDecodeHex("020a1091341fe5664bfa1782d5e04779689068c916b04cb365ec3153755684d9a1"), got.PublicKey().Bytes())
+
+	_, err = DeriveHardenedKey(nil, chaincode.ChainCodeFromDeriveIndexString("Alice"))
+	assert.Error(t, err)
+}
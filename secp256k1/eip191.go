@@ -0,0 +1,81 @@
+package secp256k1
+
+import (
+	"bytes"
+	"errors"
+	"strconv"
+
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+)
+
+// ValidatorAddressSize is the size, in bytes, of the intended validator address in EIP-191 version 0x00 data.
+const ValidatorAddressSize = 20
+
+// ErrInvalidValidatorAddress is returned when the EIP-191 intended validator address is not 20 bytes.
+var ErrInvalidValidatorAddress = errors.New("validator address must be 20 bytes")
+
+// HashPersonalMessage returns the EIP-191 version 0x45 (personal_sign) hash of the message,
+// keccak256("\x19Ethereum Signed Message:\n" + len(message) + message).
+func HashPersonalMessage(message []byte) []byte {
+	prefix := []byte("\x19Ethereum Signed Message:\n" + strconv.Itoa(len(message)))
+
+	return ethcrypto.Keccak256(prefix, message)
+}
+
+// HashDataWithValidator returns the EIP-191 version 0x00 hash of the data for the intended validator address,
+// keccak256(0x19 || 0x00 || validator || data).
+func HashDataWithValidator(validator, data []byte) ([]byte, error) {
+	if len(validator) != ValidatorAddressSize {
+		return nil, ErrInvalidValidatorAddress
+	}
+
+	return ethcrypto.Keccak256([]byte{0x19, 0x00}, validator, data), nil
+}
+
+// SignPersonalMessage signs the message as EIP-191 personal_sign does, the returned signature has a V of 27 or 28
+// the same as signatures produced by wallets.
+func (pk PrivateKey) SignPersonalMessage(message []byte) ([]byte, error) {
+	return pk.SignEthereumHash(HashPersonalMessage(message))
+}
+
+// SignDataWithValidator signs the data for the intended validator address as EIP-191 version 0x00,
+// the returned signature has a V of 27 or 28.
+func (pk PrivateKey) SignDataWithValidator(validator, data []byte) ([]byte, error) {
+	hash, err := HashDataWithValidator(validator, data)
+	if err != nil {
+		return nil, err
+	}
+
+	return pk.SignEthereumHash(hash)
+}
+
+// VerifyPersonalMessage verifies that the EIP-191 personal_sign signature of the message was created by this public key.
+// Signatures with a V of 0, 1, 27 or 28 are accepted.
+func (pk PublicKey) VerifyPersonalMessage(message, sig []byte) bool {
+	return pk.verifyRecovered(HashPersonalMessage(message), sig)
+}
+
+// VerifyDataWithValidator verifies that the EIP-191 version 0x00 signature of the data for the intended validator address
+// was created by this public key. Signatures with a V of 0, 1, 27 or 28 are accepted.
+func (pk PublicKey) VerifyDataWithValidator(validator, data, sig []byte) bool {
+	hash, err := HashDataWithValidator(validator, data)
+	if err != nil {
+		return false
+	}
+
+	return pk.verifyRecovered(hash, sig)
+}
+
+func (pk PublicKey) verifyRecovered(hash, sig []byte) bool {
+	recovered, err := RecoverPublicKey(hash, sig)
+	if err != nil {
+		return false
+	}
+
+	return bytes.Equal(recovered.Bytes(), pk.Bytes())
+}
+
+// RecoverPersonalMessage returns the public key that created the EIP-191 personal_sign signature of the message.
+func RecoverPersonalMessage(message, sig []byte) (*PublicKey, error) {
+	return RecoverPublicKey(HashPersonalMessage(message), sig)
+}
@@ -0,0 +1,73 @@
+package secp256k1
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/mailchain/go-encoding/encodingtest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHashPersonalMessage(t *testing.T) {
+	// https://web3js.readthedocs.io/en/v1.10.0/web3-eth-accounts.html#hashmessage
+	assert.Equal(t, encodingtest.MustDecodeHex("1da44b586eb0729ff70a73c326926f6ed5a25f5b056e7f47fbc6e58d86871655"), HashPersonalMessage([]byte("Some data")))
+
+	for _, message := range []string{"", "message", "a message that is longer than ten bytes"} {
+		assert.Equal(t, accounts.TextHash([]byte(message)), HashPersonalMessage([]byte(message)))
+	}
+}
+
+func TestHashDataWithValidator(t *testing.T) {
+	validator := encodingtest.MustDecodeHex("2c7536e3605d9c16a7a3d7b1898e529396a65c23")
+
+	got, err := HashDataWithValidator(validator, []byte("data"))
+	assert.NoError(t, err)
+	assert.Equal(t, ethcrypto.Keccak256(append(append([]byte{0x19, 0x00}, validator...), []byte("data")...)), got)
+
+	_, err = HashDataWithValidator(validator[:19], []byte("data"))
+	assert.ErrorIs(t, err, ErrInvalidValidatorAddress)
+}
+
+func TestSignPersonalMessage(t *testing.T) {
+	key, err := PrivateKeyFromBytes(encodingtest.MustDecodeHex("4c0883a69102937d6231471b5dbb6204fe5129617082792ae468d01a3f362318"))
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	// https://web3js.readthedocs.io/en/v1.10.0/web3-eth-accounts.html#sign
+	want := encodingtest.MustDecodeHex("b91467e570a6466aa9e9876cbcd013baba02900b8979d43fe208a4a4f339f5fd6007e74cd82e037b800186422fc2da167c747ef045e5d18a5f5d4300f8e1a0291c")
+
+	got, err := key.SignPersonalMessage([]byte("Some data"))
+	assert.NoError(t, err)
+	assert.Equal(t, want, got)
+
+	publicKey := key.PublicKey().(*PublicKey)
+	assert.True(t, publicKey.VerifyPersonalMessage([]byte("Some data"), want))
+	assert.False(t, publicKey.VerifyPersonalMessage([]byte("Other data"), want))
+	assert.False(t, bobPublicKey.VerifyPersonalMessage([]byte("Some data"), want))
+
+	zeroOneV := append([]byte{}, want...)
+	zeroOneV[64] -= 27
+	assert.True(t, publicKey.VerifyPersonalMessage([]byte("Some data"), zeroOneV))
+
+	recovered, err := RecoverPersonalMessage([]byte("Some data"), want)
+	assert.NoError(t, err)
+	assert.Equal(t, "0x2c7536E3605D9C16a7a3D7b1898e529396a65c23", ethcrypto.PubkeyToAddress(*recovered.ECDSA()).Hex())
+}
+
+func TestSignDataWithValidator(t *testing.T) {
+	validator := encodingtest.MustDecodeHex("2c7536e3605d9c16a7a3d7b1898e529396a65c23")
+
+	sig, err := bobPrivateKey.SignDataWithValidator(validator, []byte("data"))
+	assert.NoError(t, err)
+	assert.Contains(t, []byte{27, 28}, sig[64])
+	assert.True(t, bobPublicKey.VerifyDataWithValidator(validator, []byte("data"), sig))
+	assert.False(t, bobPublicKey.VerifyDataWithValidator(make([]byte, 20), []byte("data"), sig))
+	assert.False(t, bobPublicKey.VerifyDataWithValidator(validator[:19], []byte("data"), sig))
+	assert.False(t, alicePublicKey.VerifyDataWithValidator(validator, []byte("data"), sig))
+	assert.False(t, bobPublicKey.VerifyPersonalMessage([]byte("data"), sig))
+
+	_, err = bobPrivateKey.SignDataWithValidator(validator[:19], []byte("data"))
+	assert.ErrorIs(t, err, ErrInvalidValidatorAddress)
+}
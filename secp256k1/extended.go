@@ -0,0 +1,44 @@
+package secp256k1
+
+import (
+	"errors"
+
+	"github.com/mailchain/go-crypto/internal/hdkey"
+)
+
+// HardenedKeyStart is the index at which hardened BIP-32 keys begin. Indexes at or above this value
+// derive hardened children, indexes below derive normal (non-hardened) children.
+const HardenedKeyStart = hdkey.HardenedKeyStart
+
+// MaxDepth is the maximum depth of an extended key as the depth is serialized as a single byte.
+const MaxDepth = hdkey.MaxDepth
+
+const (
+	minSeedLength = 16
+	maxSeedLength = 64
+)
+
+var (
+	// VersionExtendedPrivateKey is the mainnet version prefix that results in an `xprv` encoded key.
+	VersionExtendedPrivateKey = []byte{0x04, 0x88, 0xad, 0xe4} //nolint: gochecknoglobals
+	// VersionExtendedPublicKey is the mainnet version prefix that results in an `xpub` encoded key.
+	VersionExtendedPublicKey = []byte{0x04, 0x88, 0xb2, 0x1e} //nolint: gochecknoglobals
+
+	masterKeySalt = []byte("Bitcoin seed") //nolint: gochecknoglobals
+)
+
+var (
+	// ErrInvalidSeedLength is returned when the master seed is not between 128 and 512 bits.
+	ErrInvalidSeedLength = errors.New("seed length must be between 128 and 512 bits")
+	// ErrInvalidChild is returned when the derived key is not valid for the requested index.
+	// As defined in BIP-32 the caller should proceed with the next index.
+	ErrInvalidChild = hdkey.ErrInvalidChild
+	// ErrDeriveHardenedFromPublic is returned when a hardened child is requested from an extended public key.
+	ErrDeriveHardenedFromPublic = hdkey.ErrDeriveHardenedFromPublic
+	// ErrMaxDepth is returned when deriving beyond the maximum depth.
+	ErrMaxDepth = hdkey.ErrMaxDepth
+	// ErrInvalidExtendedKey is returned when a serialized extended key is malformed.
+	ErrInvalidExtendedKey = hdkey.ErrInvalidExtendedKey
+	// ErrInvalidChecksum is returned when the checksum of a serialized extended key does not match.
+	ErrInvalidChecksum = hdkey.ErrInvalidChecksum
+)
@@ -0,0 +1,209 @@
+package secp256k1
+
+import (
+	"testing"
+
+	"github.com/mailchain/go-encoding/encodingtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Test vectors from https://github.com/bitcoin/bips/blob/master/bip-0032.mediawiki#test-vectors
+func TestExtendedPrivateKey_Derive(t *testing.T) {
+	tests := []struct {
+		name     string
+		seed     []byte
+		path     []uint32
+		wantPriv string
+		wantPub  string
+	}{
+		{
+			"vector-1-m",
+			encodingtest.MustDecodeHex("000102030405060708090a0b0c0d0e0f"),
+			[]uint32{},
+			"xprv9s21ZrQH143K3QTDL4LXw2F7HEK3wJUD2nW2nRk4stbPy6cq3jPPqjiChkVvvNKmPGJxWUtg6LnF5kejMRNNU3TGtRBeJgk33yuGBxrMPHi",
+			"xpub661MyMwAqRbcFtXgS5sYJABqqG9YLmC4Q1Rdap9gSE8NqtwybGhePY2gZ29ESFjqJoCu1Rupje8YtGqsefD265TMg7usUDFdp6W1EGMcet8",
+		},
+		{
+			"vector-1-m/0H",
+			encodingtest.MustDecodeHex("000102030405060708090a0b0c0d0e0f"),
+			[]uint32{HardenedKeyStart},
+			"xprv9uHRZZhk6KAJC1avXpDAp4MDc3sQKNxDiPvvkX8Br5ngLNv1TxvUxt4cV1rGL5hj6KCesnDYUhd7oWgT11eZG7XnxHrnYeSvkzY7d2bhkJ7",
+			"xpub68Gmy5EdvgibQVfPdqkBBCHxA5htiqg55crXYuXoQRKfDBFA1WEjWgP6LHhwBZeNK1VTsfTFUHCdrfp1bgwQ9xv5ski8PX9rL2dZXvgGDnw",
+		},
+		{
+			"vector-1-m/0H/1",
+			encodingtest.MustDecodeHex("000102030405060708090a0b0c0d0e0f"),
+			[]uint32{HardenedKeyStart, 1},
+			"xprv9wTYmMFdV23N2TdNG573QoEsfRrWKQgWeibmLntzniatZvR9BmLnvSxqu53Kw1UmYPxLgboyZQaXwTCg8MSY3H2EU4pWcQDnRnrVA1xe8fs",
+			"xpub6ASuArnXKPbfEwhqN6e3mwBcDTgzisQN1wXN9BJcM47sSikHjJf3UFHKkNAWbWMiGj7Wf5uMash7SyYq527Hqck2AxYysAA7xmALppuCkwQ",
+		},
+		{
+			"vector-1-m/0H/1/2H",
+			encodingtest.MustDecodeHex("000102030405060708090a0b0c0d0e0f"),
+			[]uint32{HardenedKeyStart, 1, HardenedKeyStart + 2},
+			"xprv9z4pot5VBttmtdRTWfWQmoH1taj2axGVzFqSb8C9xaxKymcFzXBDptWmT7FwuEzG3ryjH4ktypQSAewRiNMjANTtpgP4mLTj34bhnZX7UiM",
+			"xpub6D4BDPcP2GT577Vvch3R8wDkScZWzQzMMUm3PWbmWvVJrZwQY4VUNgqFJPMM3No2dFDFGTsxxpG5uJh7n7epu4trkrX7x7DogT5Uv6fcLW5",
+		},
+		{
+			"vector-1-m/0H/1/2H/2/1000000000",
+			encodingtest.MustDecodeHex("000102030405060708090a0b0c0d0e0f"),
+			[]uint32{HardenedKeyStart, 1, HardenedKeyStart + 2, 2, 1000000000},
+			"xprvA41z7zogVVwxVSgdKUHDy1SKmdb533PjDz7J6N6mV6uS3ze1ai8FHa8kmHScGpWmj4WggLyQjgPie1rFSruoUihUZREPSL39UNdE3BBDu76",
+			"xpub6H1LXWLaKsWFhvm6RVpEL9P4KfRZSW7abD2ttkWP3SSQvnyA8FSVqNTEcYFgJS2UaFcxupHiYkro49S8yGasTvXEYBVPamhGW6cFJodrTHy",
+		},
+		{
+			"vector-2-m/0",
+			encodingtest.MustDecodeHex("fffcf9f6f3f0edeae7e4e1dedbd8d5d2cfccc9c6c3c0bdbab7b4b1aeaba8a5a29f9c999693908d8a8784817e7b7875726f6c696663605d5a5754514e4b484542"),
+			[]uint32{0},
+			"xprv9vHkqa6EV4sPZHYqZznhT2NPtPCjKuDKGY38FBWLvgaDx45zo9WQRUT3dKYnjwih2yJD9mkrocEZXo1ex8G81dwSM1fwqWpWkeS3v86pgKt",
+			"xpub69H7F5d8KSRgmmdJg2KhpAK8SR3DjMwAdkxj3ZuxV27CprR9LgpeyGmXUbC6wb7ERfvrnKZjXoUmmDznezpbZb7ap6r1D3tgFxHmwMkQTPH",
+		},
+		{
+			"vector-2-m/0/2147483647H/1/2147483646H/2",
+			encodingtest.MustDecodeHex("fffcf9f6f3f0edeae7e4e1dedbd8d5d2cfccc9c6c3c0bdbab7b4b1aeaba8a5a29f9c999693908d8a8784817e7b7875726f6c696663605d5a5754514e4b484542"),
+			[]uint32{0, HardenedKeyStart + 2147483647, 1, HardenedKeyStart + 2147483646, 2},
+			"xprvA2nrNbFZABcdryreWet9Ea4LvTJcGsqrMzxHx98MMrotbir7yrKCEXw7nadnHM8Dq38EGfSh6dqA9QWTyefMLEcBYJUuekgW4BYPJcr9E7j",
+			"xpub6FnCn6nSzZAw5Tw7cgR9bi15UV96gLZhjDstkXXxvCLsUXBGXPdSnLFbdpq8p9HmGsApME5hQTZ3emM2rnY5agb9rXpVGyy3bdW6EEgAtqt",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			master, err := NewExtendedPrivateKeyFromSeed(tt.seed)
+			require.NoError(t, err)
+
+			key := master
+			for _, index := range tt.path {
+				child, err := key.Derive(index)
+				require.NoError(t, err)
+				key = child.(*ExtendedPrivateKey)
+			}
+
+			assert.Equal(t, tt.wantPriv, key.String())
+
+			pub, err := key.ExtendedPublicKey()
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantPub, pub.(*ExtendedPublicKey).String())
+		})
+	}
+}
+
+func TestNewExtendedPrivateKeyFromSeed(t *testing.T) {
+	tests := []struct {
+		name    string
+		seed    []byte
+		wantErr error
+	}{
+		{
+			"success-16",
+			make([]byte, 16),
+			nil,
+		},
+		{
+			"success-64",
+			make([]byte, 64),
+			nil,
+		},
+		{
+			"err-short",
+			make([]byte, 15),
+			ErrInvalidSeedLength,
+		},
+		{
+			"err-long",
+			make([]byte, 65),
+			ErrInvalidSeedLength,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewExtendedPrivateKeyFromSeed(tt.seed)
+			assert.Equal(t, tt.wantErr, err)
+			if tt.wantErr == nil {
+				assert.Equal(t, uint8(0), got.Depth())
+				assert.Equal(t, uint32(0), got.ChildNumber())
+				assert.Equal(t, []byte{0x0, 0x0, 0x0, 0x0}, got.ParentFingerprint())
+			}
+		})
+	}
+}
+
+func TestExtendedPrivateKeyFromString(t *testing.T) {
+	tests := []struct {
+		name            string
+		in              string
+		wantDepth       uint8
+		wantChildNumber uint32
+		wantParentFP    []byte
+		wantErr         bool
+	}{
+		{
+			"success-m/0H/1",
+			"xprv9wTYmMFdV23N2TdNG573QoEsfRrWKQgWeibmLntzniatZvR9BmLnvSxqu53Kw1UmYPxLgboyZQaXwTCg8MSY3H2EU4pWcQDnRnrVA1xe8fs",
+			2,
+			1,
+			encodingtest.MustDecodeHex("5c1bd648"),
+			false,
+		},
+		{
+			"err-checksum",
+			"xprv9wTYmMFdV23N2TdNG573QoEsfRrWKQgWeibmLntzniatZvR9BmLnvSxqu53Kw1UmYPxLgboyZQaXwTCg8MSY3H2EU4pWcQDnRnrVA1xe8ft",
+			0,
+			0,
+			nil,
+			true,
+		},
+		{
+			"err-public-key",
+			"xpub6ASuArnXKPbfEwhqN6e3mwBcDTgzisQN1wXN9BJcM47sSikHjJf3UFHKkNAWbWMiGj7Wf5uMash7SyYq527Hqck2AxYysAA7xmALppuCkwQ",
+			0,
+			0,
+			nil,
+			true,
+		},
+		{
+			"err-not-base58",
+			"0OIl",
+			0,
+			0,
+			nil,
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ExtendedPrivateKeyFromString(tt.in)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ExtendedPrivateKeyFromString() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			assert.Equal(t, tt.in, got.String())
+			assert.Equal(t, tt.wantDepth, got.Depth())
+			assert.Equal(t, tt.wantChildNumber, got.ChildNumber())
+			assert.Equal(t, tt.wantParentFP, got.ParentFingerprint())
+		})
+	}
+}
+
+func TestExtendedPrivateKey_Fingerprint(t *testing.T) {
+	master, err := NewExtendedPrivateKeyFromSeed(encodingtest.MustDecodeHex("000102030405060708090a0b0c0d0e0f"))
+	require.NoError(t, err)
+
+	child, err := master.Derive(HardenedKeyStart)
+	require.NoError(t, err)
+
+	assert.Equal(t, encodingtest.MustDecodeHex("3442193e"), master.Fingerprint())
+	assert.Equal(t, master.Fingerprint(), child.(*ExtendedPrivateKey).ParentFingerprint())
+}
+
+func TestExtendedPrivateKey_DeriveMaxDepth(t *testing.T) {
+	key, err := NewExtendedPrivateKeyFromSeed(encodingtest.MustDecodeHex("000102030405060708090a0b0c0d0e0f"))
+	require.NoError(t, err)
+
+	key.meta.Depth = MaxDepth
+	_, err = key.Derive(0)
+	assert.Equal(t, ErrMaxDepth, err)
+}
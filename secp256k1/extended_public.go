@@ -0,0 +1,123 @@
+package secp256k1
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/mailchain/go-crypto"
+	"github.com/mailchain/go-crypto/internal/hdkey"
+)
+
+// ExtendedPublicKey is a BIP-32 hierarchical deterministic public key based on the secp256k1 curve.
+// Only non-hardened children can be derived from an extended public key.
+type ExtendedPublicKey struct {
+	meta hdkey.Metadata
+	key  PublicKey
+}
+
+// ExtendedPublicKeyFromBytes creates an extended public key from its 78 byte BIP-32 serialization.
+func ExtendedPublicKeyFromBytes(in []byte) (*ExtendedPublicKey, error) {
+	meta, keyData, err := hdkey.Deserialize(in, VersionExtendedPublicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if keyData[0] != 0x02 && keyData[0] != 0x03 {
+		return nil, ErrInvalidExtendedKey
+	}
+
+	key, err := PublicKeyFromBytes(keyData)
+	if err != nil {
+		return nil, ErrInvalidExtendedKey
+	}
+
+	return &ExtendedPublicKey{meta: meta, key: *key.(*PublicKey)}, nil
+}
+
+// ExtendedPublicKeyFromString creates an extended public key from its base58 check encoded `xpub` form.
+func ExtendedPublicKeyFromString(in string) (*ExtendedPublicKey, error) {
+	data, err := hdkey.DecodeBase58Check(in)
+	if err != nil {
+		return nil, err
+	}
+
+	return ExtendedPublicKeyFromBytes(data)
+}
+
+// Bytes returns the 78 byte BIP-32 serialization of the extended public key.
+func (k ExtendedPublicKey) Bytes() []byte {
+	return k.meta.Serialize(VersionExtendedPublicKey, k.key.Bytes())
+}
+
+// String returns the base58 check encoded `xpub` form of the extended public key.
+func (k ExtendedPublicKey) String() string {
+	return hdkey.EncodeBase58Check(k.Bytes())
+}
+
+// PublicKey returns the public key.
+func (k ExtendedPublicKey) PublicKey() crypto.PublicKey {
+	return &k.key
+}
+
+// ChainCode returns the chain code used to derive child keys.
+func (k ExtendedPublicKey) ChainCode() []byte {
+	return k.meta.ChainCode
+}
+
+// Depth returns the number of derivations from the master key, the master key has a depth of 0.
+func (k ExtendedPublicKey) Depth() uint8 {
+	return k.meta.Depth
+}
+
+// ChildNumber returns the index that was used to derive this key from its parent.
+func (k ExtendedPublicKey) ChildNumber() uint32 {
+	return k.meta.ChildNumber
+}
+
+// Fingerprint returns the fingerprint of this key, it is the parent fingerprint of its children.
+func (k ExtendedPublicKey) Fingerprint() []byte {
+	return hdkey.Fingerprint(k.key.Bytes())
+}
+
+// ParentFingerprint returns the fingerprint of the parent key, the master key has a parent fingerprint of 0x00000000.
+func (k ExtendedPublicKey) ParentFingerprint() []byte {
+	return k.meta.ParentFingerprint
+}
+
+// Derive the non-hardened child extended public key at index.
+// ErrDeriveHardenedFromPublic is returned when index is equal to or greater than HardenedKeyStart.
+func (k ExtendedPublicKey) Derive(index uint32) (crypto.ExtendedPublicKey, error) {
+	return k.derive(index)
+}
+
+func (k ExtendedPublicKey) derive(index uint32) (*ExtendedPublicKey, error) {
+	if hdkey.IsHardened(index) {
+		return nil, ErrDeriveHardenedFromPublic
+	}
+
+	parentPublicKey := k.key.Bytes()
+	il, ir := hdkey.HMACSHA512(k.meta.ChainCode, append(append([]byte{}, parentPublicKey...), hdkey.Uint32Bytes(index)...))
+
+	curve := ethcrypto.S256()
+	if new(big.Int).SetBytes(il).Cmp(curve.Params().N) >= 0 {
+		return nil, ErrInvalidChild
+	}
+
+	ilX, ilY := curve.ScalarBaseMult(il)
+	childX, childY := curve.Add(ilX, ilY, k.key.ecdsa.X, k.key.ecdsa.Y)
+
+	if childX.Sign() == 0 && childY.Sign() == 0 {
+		return nil, ErrInvalidChild
+	}
+
+	childMeta, err := k.meta.Child(parentPublicKey, index, ir)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ExtendedPublicKey{
+		meta: childMeta,
+		key:  PublicKey{ecdsa: ecdsa.PublicKey{Curve: curve, X: childX, Y: childY}},
+	}, nil
+}
@@ -0,0 +1,131 @@
+package secp256k1
+
+import (
+	"testing"
+
+	"github.com/mailchain/go-encoding/encodingtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtendedPublicKey_Derive(t *testing.T) {
+	tests := []struct {
+		name    string
+		parent  string
+		index   uint32
+		want    string
+		wantErr error
+	}{
+		{
+			"vector-1-m/0H/1",
+			"xpub68Gmy5EdvgibQVfPdqkBBCHxA5htiqg55crXYuXoQRKfDBFA1WEjWgP6LHhwBZeNK1VTsfTFUHCdrfp1bgwQ9xv5ski8PX9rL2dZXvgGDnw",
+			1,
+			"xpub6ASuArnXKPbfEwhqN6e3mwBcDTgzisQN1wXN9BJcM47sSikHjJf3UFHKkNAWbWMiGj7Wf5uMash7SyYq527Hqck2AxYysAA7xmALppuCkwQ",
+			nil,
+		},
+		{
+			"vector-1-m/0H/1/2H/2",
+			"xpub6D4BDPcP2GT577Vvch3R8wDkScZWzQzMMUm3PWbmWvVJrZwQY4VUNgqFJPMM3No2dFDFGTsxxpG5uJh7n7epu4trkrX7x7DogT5Uv6fcLW5",
+			2,
+			"xpub6FHa3pjLCk84BayeJxFW2SP4XRrFd1JYnxeLeU8EqN3vDfZmbqBqaGJAyiLjTAwm6ZLRQUMv1ZACTj37sR62cfN7fe5JnJ7dh8zL4fiyLHV",
+			nil,
+		},
+		{
+			"vector-2-m/0",
+			"xpub661MyMwAqRbcFW31YEwpkMuc5THy2PSt5bDMsktWQcFF8syAmRUapSCGu8ED9W6oDMSgv6Zz8idoc4a6mr8BDzTJY47LJhkJ8UB7WEGuduB",
+			0,
+			"xpub69H7F5d8KSRgmmdJg2KhpAK8SR3DjMwAdkxj3ZuxV27CprR9LgpeyGmXUbC6wb7ERfvrnKZjXoUmmDznezpbZb7ap6r1D3tgFxHmwMkQTPH",
+			nil,
+		},
+		{
+			"err-hardened",
+			"xpub661MyMwAqRbcFtXgS5sYJABqqG9YLmC4Q1Rdap9gSE8NqtwybGhePY2gZ29ESFjqJoCu1Rupje8YtGqsefD265TMg7usUDFdp6W1EGMcet8",
+			HardenedKeyStart,
+			"",
+			ErrDeriveHardenedFromPublic,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			parent, err := ExtendedPublicKeyFromString(tt.parent)
+			require.NoError(t, err)
+
+			got, err := parent.Derive(tt.index)
+			assert.Equal(t, tt.wantErr, err)
+			if tt.wantErr != nil {
+				return
+			}
+
+			assert.Equal(t, tt.want, got.(*ExtendedPublicKey).String())
+			assert.Equal(t, parent.Fingerprint(), got.(*ExtendedPublicKey).ParentFingerprint())
+		})
+	}
+}
+
+func TestExtendedPublicKey_MatchesPrivateDerivation(t *testing.T) {
+	master, err := NewExtendedPrivateKeyFromSeed(encodingtest.MustDecodeHex("000102030405060708090a0b0c0d0e0f"))
+	require.NoError(t, err)
+
+	masterPub, err := master.ExtendedPublicKey()
+	require.NoError(t, err)
+
+	for _, index := range []uint32{0, 1, 2, 1000, HardenedKeyStart - 1} {
+		privChild, err := master.Derive(index)
+		require.NoError(t, err)
+
+		pubChild, err := masterPub.Derive(index)
+		require.NoError(t, err)
+
+		privChildPub, err := privChild.ExtendedPublicKey()
+		require.NoError(t, err)
+
+		assert.Equal(t, privChildPub.Bytes(), pubChild.Bytes())
+		assert.Equal(t, privChild.PrivateKey().PublicKey().Bytes(), pubChild.PublicKey().Bytes())
+	}
+}
+
+func TestExtendedPublicKeyFromBytes(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      []byte
+		wantErr bool
+	}{
+		{
+			"success",
+			encodingtest.MustDecodeHex("0488b21e000000000000000000873dff81c02f525623fd1fe5167eac3a55a049de3d314bb42ee227ffed37d5080339a36013301597daef41fbe593a02cc513d0b55527ec2df1050e2e8ff49c85c2"),
+			false,
+		},
+		{
+			"err-version",
+			encodingtest.MustDecodeHex("0488ade4000000000000000000873dff81c02f525623fd1fe5167eac3a55a049de3d314bb42ee227ffed37d5080339a36013301597daef41fbe593a02cc513d0b55527ec2df1050e2e8ff49c85c2"),
+			true,
+		},
+		{
+			"err-key-prefix",
+			encodingtest.MustDecodeHex("0488b21e000000000000000000873dff81c02f525623fd1fe5167eac3a55a049de3d314bb42ee227ffed37d5080439a36013301597daef41fbe593a02cc513d0b55527ec2df1050e2e8ff49c85c2"),
+			true,
+		},
+		{
+			"err-master-parent-fingerprint",
+			encodingtest.MustDecodeHex("0488b21e000000000100000000873dff81c02f525623fd1fe5167eac3a55a049de3d314bb42ee227ffed37d5080339a36013301597daef41fbe593a02cc513d0b55527ec2df1050e2e8ff49c85c2"),
+			true,
+		},
+		{
+			"err-length",
+			encodingtest.MustDecodeHex("0488b21e00"),
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ExtendedPublicKeyFromBytes(tt.in)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ExtendedPublicKeyFromBytes() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr {
+				assert.Equal(t, tt.in, got.Bytes())
+			}
+		})
+	}
+}
@@ -2,7 +2,6 @@ package secp256k1
 
 import (
 	"crypto/ecdsa"
-	"errors"
 	"fmt"
 	"io"
 	"math/big"
@@ -18,7 +17,7 @@ var (
 	// usable due to the derived key falling outside of the valid range for
 	// secp256k1 private keys.  This error indicates the caller must choose
 	// another seed.
-	ErrUnusableSeed = errors.New("unusable seed")
+	ErrUnusableSeed = crypto.ErrUnusableSeed
 )
 
 // PrivateKey based on the secp256k1 curve.
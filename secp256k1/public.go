@@ -15,6 +15,7 @@ type PublicKey struct {
 }
 
 // Verify verifies whether sig is a valid signature of message.
+// The recovery ID of a 65 byte signature is ignored, use VerifyStrict when every byte of the signature must be checked.
 func (pk PublicKey) Verify(message, sig []byte) bool {
 	if len(sig) == 65 {
 		sig = sig[:64]
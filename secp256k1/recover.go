@@ -0,0 +1,77 @@
+package secp256k1
+
+import (
+	"errors"
+	"fmt"
+
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+)
+
+const (
+	// RecoverableSignatureSize is the size, in bytes, of a signature with the recovery ID as the last byte.
+	RecoverableSignatureSize = 65
+	// HashSize is the size, in bytes, of the message hash that is signed.
+	HashSize = 32
+
+	// ethereumRecoveryIDOffset is added to the recovery ID in Ethereum style signatures, resulting in a V of 27 or 28.
+	ethereumRecoveryIDOffset = 27
+)
+
+var (
+	// ErrInvalidSignatureLength is returned when a recoverable signature is not 65 bytes.
+	ErrInvalidSignatureLength = errors.New("signature must be 65 bytes")
+	// ErrInvalidHashLength is returned when the signed hash is not 32 bytes.
+	ErrInvalidHashLength = errors.New("hash must be 32 bytes")
+	// ErrInvalidRecoveryID is returned when the recovery ID is not 0, 1, 27 or 28.
+	ErrInvalidRecoveryID = errors.New("recovery id must be 0, 1, 27 or 28")
+)
+
+// RecoverPublicKey returns the public key that created the signature of hash.
+// The last byte of the 65 byte signature is the recovery ID, both the 0/1 form returned by Sign and the 27/28 form
+// used by Ethereum are supported.
+func RecoverPublicKey(hash, sig []byte) (*PublicKey, error) {
+	if len(hash) != HashSize {
+		return nil, ErrInvalidHashLength
+	}
+
+	if len(sig) != RecoverableSignatureSize {
+		return nil, ErrInvalidSignatureLength
+	}
+
+	v := sig[64]
+	if v >= ethereumRecoveryIDOffset {
+		v -= ethereumRecoveryIDOffset
+	}
+
+	if v > 1 {
+		return nil, ErrInvalidRecoveryID
+	}
+
+	normalized := make([]byte, RecoverableSignatureSize)
+	copy(normalized, sig[:64])
+	normalized[64] = v
+
+	pk, err := ethcrypto.SigToPub(hash, normalized)
+	if err != nil {
+		return nil, fmt.Errorf("could not recover public key: %w", err)
+	}
+
+	return &PublicKey{ecdsa: *pk}, nil
+}
+
+// SignEthereumHash signs the 32 byte hash, the returned signature has a V of 27 or 28 the same as the signatures
+// produced by Ethereum wallets. RecoverPublicKey accepts the signature.
+func (pk PrivateKey) SignEthereumHash(hash []byte) ([]byte, error) {
+	if len(hash) != HashSize {
+		return nil, ErrInvalidHashLength
+	}
+
+	sig, err := pk.Sign(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	sig[64] += ethereumRecoveryIDOffset
+
+	return sig, nil
+}
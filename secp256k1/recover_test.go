@@ -0,0 +1,145 @@
+package secp256k1
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecoverPublicKey(t *testing.T) {
+	hash := sha256.Sum256([]byte("message"))
+	bobSig, err := bobPrivateKey.Sign(hash[:])
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	aliceSig, err := alicePrivateKey.Sign(hash[:])
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	withV := func(sig []byte, v byte) []byte {
+		out := append([]byte{}, sig...)
+		out[64] = v
+
+		return out
+	}
+
+	tests := []struct {
+		name    string
+		hash    []byte
+		sig     []byte
+		want    *PublicKey
+		wantErr error
+	}{
+		{
+			"bob",
+			hash[:],
+			bobSig,
+			&bobPublicKey,
+			nil,
+		},
+		{
+			"bob-ethereum-v",
+			hash[:],
+			withV(bobSig, bobSig[64]+27),
+			&bobPublicKey,
+			nil,
+		},
+		{
+			"alice",
+			hash[:],
+			aliceSig,
+			&alicePublicKey,
+			nil,
+		},
+		{
+			"alice-ethereum-v",
+			hash[:],
+			withV(aliceSig, aliceSig[64]+27),
+			&alicePublicKey,
+			nil,
+		},
+		{
+			"err-recovery-id-2",
+			hash[:],
+			withV(bobSig, 2),
+			nil,
+			ErrInvalidRecoveryID,
+		},
+		{
+			"err-recovery-id-29",
+			hash[:],
+			withV(bobSig, 29),
+			nil,
+			ErrInvalidRecoveryID,
+		},
+		{
+			"err-signature-length",
+			hash[:],
+			bobSig[:64],
+			nil,
+			ErrInvalidSignatureLength,
+		},
+		{
+			"err-hash-length",
+			hash[:31],
+			bobSig,
+			nil,
+			ErrInvalidHashLength,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := RecoverPublicKey(tt.hash, tt.sig)
+			assert.ErrorIs(t, err, tt.wantErr)
+			if tt.want == nil {
+				assert.Nil(t, got)
+				return
+			}
+			assert.Equal(t, tt.want.Bytes(), got.Bytes())
+		})
+	}
+}
+
+func TestRecoverPublicKeyInvalidSignature(t *testing.T) {
+	hash := sha256.Sum256([]byte("message"))
+
+	got, err := RecoverPublicKey(hash[:], make([]byte, 65))
+	assert.Error(t, err)
+	assert.Nil(t, got)
+
+	otherHash := sha256.Sum256([]byte("egassem"))
+	sig, err := bobPrivateKey.Sign(hash[:])
+	assert.NoError(t, err)
+
+	recovered, err := RecoverPublicKey(otherHash[:], sig)
+	if err == nil {
+		assert.NotEqual(t, bobPublicKey.Bytes(), recovered.Bytes())
+	}
+}
+
+func TestSignEthereumHash(t *testing.T) {
+	hash := sha256.Sum256([]byte("message"))
+
+	got, err := bobPrivateKey.SignEthereumHash(hash[:])
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	sig, err := bobPrivateKey.Sign(hash[:])
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	assert.Equal(t, sig[:64], got[:64])
+	assert.Equal(t, sig[64]+27, got[64])
+
+	recovered, err := RecoverPublicKey(hash[:], got)
+	assert.NoError(t, err)
+	assert.Equal(t, bobPublicKey.Bytes(), recovered.Bytes())
+
+	_, err = bobPrivateKey.SignEthereumHash(hash[:31])
+	assert.ErrorIs(t, err, ErrInvalidHashLength)
+}
@@ -0,0 +1,93 @@
+package secp256k1
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+)
+
+const (
+	// XOnlyPublicKeySize is the size, in bytes, of BIP-340 x-only public keys.
+	XOnlyPublicKeySize = schnorr.PubKeyBytesLen
+	// SchnorrSignatureSize is the size, in bytes, of BIP-340 signatures.
+	SchnorrSignatureSize = 64
+)
+
+// ErrInvalidXOnlyPublicKey is returned when an x-only public key is not 32 bytes or not the x coordinate of a point on the curve.
+var ErrInvalidXOnlyPublicKey = errors.New("x-only public key must be the 32 byte x coordinate of a point on the curve")
+
+// SignSchnorr signs the 32 byte message hash using BIP-340 Schnorr signatures with auxiliary randomness from crypto/rand.
+func (pk PrivateKey) SignSchnorr(hash []byte) ([]byte, error) {
+	return pk.SignSchnorrWithRand(rand.Reader, hash)
+}
+
+// SignSchnorrWithRand signs the 32 byte message hash using BIP-340 Schnorr signatures with 32 bytes of auxiliary randomness read from rand.
+// The auxiliary randomness protects against side channel attacks, a fixed rand results in reproducible signatures.
+func (pk PrivateKey) SignSchnorrWithRand(rand io.Reader, hash []byte) ([]byte, error) {
+	if len(hash) != HashSize {
+		return nil, ErrInvalidHashLength
+	}
+
+	var aux [32]byte
+	if _, err := io.ReadFull(rand, aux[:]); err != nil {
+		return nil, fmt.Errorf("could not read auxiliary randomness: %w", err)
+	}
+
+	// the private key is parsed for every signature as schnorr.Sign negates it in place when the public key has an odd y coordinate
+	key, _ := btcec.PrivKeyFromBytes(pk.Bytes())
+
+	sig, err := schnorr.Sign(key, hash, schnorr.CustomNonce(aux))
+	if err != nil {
+		return nil, err
+	}
+
+	return sig.Serialize(), nil
+}
+
+// VerifySchnorr verifies whether sig is a valid BIP-340 Schnorr signature of the 32 byte message hash for the x-only form of this public key.
+func (pk PublicKey) VerifySchnorr(hash, sig []byte) bool {
+	if len(hash) != HashSize || len(sig) != SchnorrSignatureSize {
+		return false
+	}
+
+	signature, err := schnorr.ParseSignature(sig)
+	if err != nil {
+		return false
+	}
+
+	key, err := schnorr.ParsePubKey(pk.XOnlyBytes())
+	if err != nil {
+		return false
+	}
+
+	return signature.Verify(hash, key)
+}
+
+// XOnlyBytes returns the 32 byte BIP-340 x-only form of the public key, the x coordinate without the parity of y.
+func (pk PublicKey) XOnlyBytes() []byte {
+	return pk.Bytes()[1:]
+}
+
+// PublicKeyFromXOnlyBytes creates a public key from its 32 byte BIP-340 x-only form.
+// As defined by BIP-340 the public key with the even y coordinate is returned.
+func PublicKeyFromXOnlyBytes(keyBytes []byte) (*PublicKey, error) {
+	if len(keyBytes) != XOnlyPublicKeySize {
+		return nil, ErrInvalidXOnlyPublicKey
+	}
+
+	key, err := schnorr.ParsePubKey(keyBytes)
+	if err != nil {
+		return nil, ErrInvalidXOnlyPublicKey
+	}
+
+	publicKey, err := PublicKeyFromBytes(key.SerializeCompressed())
+	if err != nil {
+		return nil, err
+	}
+
+	return publicKey.(*PublicKey), nil
+}
@@ -0,0 +1,43 @@
+package secp256k1
+
+import (
+	"bytes"
+
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/mailchain/go-crypto/internal/ecdsasig"
+)
+
+// VerifyStrict verifies whether sig is a valid signature of message, only accepting a single encoding of each signature.
+// It is the recommended verification where every party must agree on which signatures are valid, for example consensus.
+// The message must be a 32 byte hash and r and s must be between 1 and the curve order - 1 with a low S value.
+// The signature is either the 64 byte r || s form or the 65 byte form returned by Sign, where the recovery ID must be
+// 0 or 1 and recover this public key.
+func (pk PublicKey) VerifyStrict(message, sig []byte) bool {
+	if len(message) != HashSize || pk.ecdsa.X == nil {
+		return false
+	}
+
+	switch len(sig) {
+	case SignatureSize:
+	case RecoverableSignatureSize:
+		if sig[SignatureSize] > 1 {
+			return false
+		}
+
+		recovered, err := RecoverPublicKey(message, sig)
+		if err != nil || !bytes.Equal(recovered.Bytes(), pk.Bytes()) {
+			return false
+		}
+	default:
+		return false
+	}
+
+	n := ethcrypto.S256().Params().N
+
+	_, s, err := ecdsasig.ParseFixed(sig[:SignatureSize], n)
+	if err != nil || !ecdsasig.IsLowS(s, n) {
+		return false
+	}
+
+	return ethcrypto.VerifySignature(pk.Bytes(), message, sig[:SignatureSize])
+}
@@ -0,0 +1,118 @@
+package secp256k1
+
+import (
+	"crypto/sha256"
+	"math/big"
+	"testing"
+
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/mailchain/go-crypto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPublicKey_VerifyStrict(t *testing.T) {
+	digest := sha256.Sum256([]byte("message"))
+
+	sig, err := bobPrivateKey.Sign(digest[:])
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	n := ethcrypto.S256().Params().N
+	highS := append([]byte{}, sig[:32]...)
+	highS = append(highS, new(big.Int).Sub(n, new(big.Int).SetBytes(sig[32:64])).FillBytes(make([]byte, 32))...)
+
+	tests := []struct {
+		name    string
+		pk      PublicKey
+		message []byte
+		sig     []byte
+		want    bool
+	}{
+		{
+			"recoverable",
+			bobPublicKey,
+			digest[:],
+			sig,
+			true,
+		},
+		{
+			"without-recovery-id",
+			bobPublicKey,
+			digest[:],
+			sig[:64],
+			true,
+		},
+		{
+			"err-wrong-key",
+			alicePublicKey,
+			digest[:],
+			sig,
+			false,
+		},
+		{
+			"err-wrong-key-without-recovery-id",
+			alicePublicKey,
+			digest[:],
+			sig[:64],
+			false,
+		},
+		{
+			"err-ethereum-recovery-id",
+			bobPublicKey,
+			digest[:],
+			append(append([]byte{}, sig[:64]...), sig[64]+ethereumRecoveryIDOffset),
+			false,
+		},
+		{
+			"err-wrong-recovery-id",
+			bobPublicKey,
+			digest[:],
+			append(append([]byte{}, sig[:64]...), sig[64]^1),
+			false,
+		},
+		{
+			"err-high-s",
+			bobPublicKey,
+			digest[:],
+			highS,
+			false,
+		},
+		{
+			"err-short-message",
+			bobPublicKey,
+			digest[:31],
+			sig,
+			false,
+		},
+		{
+			"err-short-signature",
+			bobPublicKey,
+			digest[:],
+			sig[:63],
+			false,
+		},
+		{
+			"err-empty-signature",
+			bobPublicKey,
+			digest[:],
+			nil,
+			false,
+		},
+		{
+			"err-zero-public-key",
+			PublicKey{},
+			digest[:],
+			sig,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			original := append([]byte{}, tt.sig...)
+			assert.Equal(t, tt.want, tt.pk.VerifyStrict(tt.message, tt.sig))
+			assert.Equal(t, tt.want, crypto.VerifyStrict(tt.pk, tt.message, tt.sig))
+			assert.Equal(t, original, append([]byte{}, tt.sig...))
+		})
+	}
+}
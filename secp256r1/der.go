@@ -0,0 +1,49 @@
+package secp256r1
+
+import (
+	"crypto/elliptic"
+
+	"github.com/mailchain/go-crypto/internal/ecdsasig"
+)
+
+// SignatureSize is the size, in bytes, of the fixed width r || s signatures created by Sign, also known as IEEE P1363.
+const SignatureSize = 64
+
+var (
+	// ErrInvalidDER is returned when a signature is not a strict DER encoded ECDSA signature.
+	ErrInvalidDER = ecdsasig.ErrInvalidDER
+	// ErrInvalidSignatureLength is returned when a fixed width signature is not 64 bytes.
+	ErrInvalidSignatureLength = ecdsasig.ErrInvalidFixed
+	// ErrSignatureOutOfRange is returned when r or s is not between 1 and the curve order - 1.
+	ErrSignatureOutOfRange = ecdsasig.ErrOutOfRange
+)
+
+// SignatureToDER converts the 64 byte r || s signature returned by Sign to ASN.1 DER as used by WebAuthn, X.509 and Java.
+func SignatureToDER(sig []byte) ([]byte, error) {
+	return ecdsasig.FixedToDER(sig, elliptic.P256().Params().N)
+}
+
+// SignatureFromDER converts the strict ASN.1 DER encoded signature to the 64 byte r || s form accepted by Verify.
+func SignatureFromDER(der []byte) ([]byte, error) {
+	return ecdsasig.DERToFixed(der, elliptic.P256().Params().N)
+}
+
+// VerifyDER verifies whether the strict ASN.1 DER encoded sig is a valid signature of message.
+func (pk PublicKey) VerifyDER(message, der []byte) bool {
+	sig, err := SignatureFromDER(der)
+	if err != nil {
+		return false
+	}
+
+	return pk.Verify(message, sig)
+}
+
+// VerifyAnyFormat verifies whether sig is a valid signature of message where sig is either the 64 byte r || s form
+// or strict ASN.1 DER.
+func (pk PublicKey) VerifyAnyFormat(message, sig []byte) bool {
+	if len(sig) == SignatureSize && pk.Verify(message, sig) {
+		return true
+	}
+
+	return pk.VerifyDER(message, sig)
+}
@@ -0,0 +1,56 @@
+package secp256r1
+
+import (
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/sha256"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSignatureDER(t *testing.T) {
+	key, err := PrivateKeyFromBytes(rfc6979PrivateKey)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	publicKey := key.PublicKey().(*PublicKey)
+	digest := sha256.Sum256([]byte("message"))
+
+	sig, err := key.Sign(digest[:])
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	der, err := SignatureToDER(sig)
+	assert.NoError(t, err)
+	assert.True(t, ecdsa.VerifyASN1(&publicKey.Key, digest[:], der))
+	assert.True(t, publicKey.VerifyDER(digest[:], der))
+	assert.True(t, publicKey.VerifyAnyFormat(digest[:], der))
+	assert.True(t, publicKey.VerifyAnyFormat(digest[:], sig))
+	assert.False(t, publicKey.VerifyDER(digest[:], sig))
+
+	fixed, err := SignatureFromDER(der)
+	assert.NoError(t, err)
+	assert.Equal(t, sig, fixed)
+
+	stdlibDER, err := ecdsa.SignASN1(rand.Reader, &key.key, digest[:])
+	assert.NoError(t, err)
+	assert.True(t, publicKey.VerifyDER(digest[:], stdlibDER))
+	assert.True(t, publicKey.VerifyAnyFormat(digest[:], stdlibDER))
+
+	otherDigest := sha256.Sum256([]byte("egassem"))
+	assert.False(t, publicKey.VerifyDER(otherDigest[:], der))
+	assert.False(t, publicKey.VerifyAnyFormat(otherDigest[:], der))
+	assert.False(t, publicKey.VerifyAnyFormat(otherDigest[:], sig))
+
+	trailing := append(append([]byte{}, der...), 0x00)
+	assert.False(t, publicKey.VerifyDER(digest[:], trailing))
+
+	_, err = SignatureFromDER(trailing)
+	assert.ErrorIs(t, err, ErrInvalidDER)
+
+	_, err = SignatureToDER(sig[:63])
+	assert.ErrorIs(t, err, ErrInvalidSignatureLength)
+}
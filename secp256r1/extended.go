@@ -0,0 +1,44 @@
+package secp256r1
+
+import (
+	"crypto/elliptic"
+	"errors"
+	"math/big"
+
+	"github.com/mailchain/go-crypto/internal/hdkey"
+)
+
+// HardenedKeyStart is the index at which hardened SLIP-0010 keys begin. Indexes at or above this value
+// derive hardened children, indexes below derive normal (non-hardened) children.
+const HardenedKeyStart = hdkey.HardenedKeyStart
+
+const (
+	minSeedLength = 16
+	maxSeedLength = 64
+)
+
+var masterKeySalt = []byte("Nist256p1 seed") //nolint: gochecknoglobals
+
+var (
+	// ErrInvalidSeedLength is returned when the master seed is not between 128 and 512 bits.
+	ErrInvalidSeedLength = errors.New("seed length must be between 128 and 512 bits")
+	// ErrDeriveHardenedFromPublic is returned when a hardened child is requested from an extended public key.
+	ErrDeriveHardenedFromPublic = hdkey.ErrDeriveHardenedFromPublic
+	// ErrMaxDepth is returned when deriving beyond the maximum depth.
+	ErrMaxDepth = hdkey.ErrMaxDepth
+	// ErrInvalidExtendedKey is returned when a serialized extended key is malformed.
+	ErrInvalidExtendedKey = hdkey.ErrInvalidExtendedKey
+)
+
+// isValidScalar reports whether in is within the range [1, n-1] for the P-256 curve.
+func isValidScalar(in *big.Int) bool {
+	return in.Sign() > 0 && in.Cmp(elliptic.P256().Params().N) < 0
+}
+
+// retryData returns the data used to compute the next HMAC when a derived key is invalid.
+// As defined in SLIP-0010 `I = HMAC-SHA512(Key = c_par, Data = 0x01 || I_R || ser_32(i))`.
+func retryData(ir []byte, index uint32) []byte {
+	data := append([]byte{0x01}, ir...)
+
+	return append(data, hdkey.Uint32Bytes(index)...)
+}
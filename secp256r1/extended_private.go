@@ -0,0 +1,142 @@
+package secp256r1
+
+import (
+	"crypto/elliptic"
+	"math/big"
+
+	"github.com/mailchain/go-crypto"
+	"github.com/mailchain/go-crypto/internal/hdkey"
+)
+
+// ExtendedPrivateKey is a SLIP-0010 hierarchical deterministic private key based on the p256 curve.
+type ExtendedPrivateKey struct {
+	meta hdkey.Metadata
+	key  PrivateKey
+}
+
+// NewExtendedPrivateKeyFromSeed creates the SLIP-0010 master extended private key from a seed.
+// The seed must be between 16 and 64 bytes, a 64 byte seed is recommended.
+func NewExtendedPrivateKeyFromSeed(seed []byte) (*ExtendedPrivateKey, error) {
+	if len(seed) < minSeedLength || len(seed) > maxSeedLength {
+		return nil, ErrInvalidSeedLength
+	}
+
+	il, ir := hdkey.HMACSHA512(masterKeySalt, seed)
+	// SLIP-0010 repeats the HMAC with `I` as data until a valid key is found.
+	for !isValidScalar(new(big.Int).SetBytes(il)) {
+		il, ir = hdkey.HMACSHA512(masterKeySalt, append(il, ir...))
+	}
+
+	key, err := PrivateKeyFromBytes(il)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ExtendedPrivateKey{meta: hdkey.Master(ir), key: *key}, nil
+}
+
+// ExtendedPrivateKeyFromBytes creates an extended private key from the 74 byte serialization returned by Bytes.
+func ExtendedPrivateKeyFromBytes(in []byte) (*ExtendedPrivateKey, error) {
+	meta, keyData, err := hdkey.Deserialize(in, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if keyData[0] != 0x00 || !isValidScalar(new(big.Int).SetBytes(keyData[1:])) {
+		return nil, ErrInvalidExtendedKey
+	}
+
+	key, err := PrivateKeyFromBytes(keyData[1:])
+	if err != nil {
+		return nil, ErrInvalidExtendedKey
+	}
+
+	return &ExtendedPrivateKey{meta: meta, key: *key}, nil
+}
+
+// Bytes returns the BIP-32 layout of the extended private key without the version prefix,
+// SLIP-0010 does not define version bytes for p256.
+func (k ExtendedPrivateKey) Bytes() []byte {
+	return k.meta.Serialize(nil, append([]byte{0x00}, k.key.Bytes()...))
+}
+
+// PrivateKey returns the private key.
+func (k ExtendedPrivateKey) PrivateKey() crypto.PrivateKey {
+	return &k.key
+}
+
+// ChainCode returns the chain code used to derive child keys.
+func (k ExtendedPrivateKey) ChainCode() []byte {
+	return k.meta.ChainCode
+}
+
+// Depth returns the number of derivations from the master key, the master key has a depth of 0.
+func (k ExtendedPrivateKey) Depth() uint8 {
+	return k.meta.Depth
+}
+
+// ChildNumber returns the index that was used to derive this key from its parent.
+func (k ExtendedPrivateKey) ChildNumber() uint32 {
+	return k.meta.ChildNumber
+}
+
+// Fingerprint returns the fingerprint of this key, it is the parent fingerprint of its children.
+func (k ExtendedPrivateKey) Fingerprint() []byte {
+	return hdkey.Fingerprint(k.key.PublicKey().Bytes())
+}
+
+// ParentFingerprint returns the fingerprint of the parent key, the master key has a parent fingerprint of 0x00000000.
+func (k ExtendedPrivateKey) ParentFingerprint() []byte {
+	return k.meta.ParentFingerprint
+}
+
+// Derive the child extended private key at index.
+// Hardened keys are derived when index is equal to or greater than HardenedKeyStart.
+func (k ExtendedPrivateKey) Derive(index uint32) (crypto.ExtendedPrivateKey, error) {
+	return k.derive(index)
+}
+
+func (k ExtendedPrivateKey) derive(index uint32) (*ExtendedPrivateKey, error) {
+	parentPublicKey := k.key.PublicKey().Bytes()
+
+	var data []byte
+	if hdkey.IsHardened(index) {
+		data = append([]byte{0x00}, k.key.Bytes()...)
+	} else {
+		data = append([]byte{}, parentPublicKey...)
+	}
+
+	curveOrder := elliptic.P256().Params().N
+	parentNum := new(big.Int).SetBytes(k.key.Bytes())
+
+	il, ir := hdkey.HMACSHA512(k.meta.ChainCode, append(data, hdkey.Uint32Bytes(index)...))
+	for {
+		ilNum := new(big.Int).SetBytes(il)
+		childNum := new(big.Int).Add(ilNum, parentNum)
+		childNum.Mod(childNum, curveOrder)
+
+		if ilNum.Cmp(curveOrder) < 0 && childNum.Sign() != 0 {
+			il = childNum.FillBytes(make([]byte, 32))
+			break
+		}
+
+		il, ir = hdkey.HMACSHA512(k.meta.ChainCode, retryData(ir, index))
+	}
+
+	childKey, err := PrivateKeyFromBytes(il)
+	if err != nil {
+		return nil, err
+	}
+
+	childMeta, err := k.meta.Child(parentPublicKey, index, ir)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ExtendedPrivateKey{meta: childMeta, key: *childKey}, nil
+}
+
+// ExtendedPublicKey returns the extended public key that corresponds to this extended private key.
+func (k ExtendedPrivateKey) ExtendedPublicKey() (crypto.ExtendedPublicKey, error) {
+	return &ExtendedPublicKey{meta: k.meta, key: *k.key.PublicKey().(*PublicKey)}, nil
+}
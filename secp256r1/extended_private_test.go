@@ -0,0 +1,153 @@
+package secp256r1
+
+import (
+	"testing"
+
+	"github.com/mailchain/go-encoding/encodingtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Test vectors from https://github.com/satoshilabs/slips/blob/master/slip-0010.md#test-vector-1-for-nist256p1
+func TestExtendedPrivateKey_Derive(t *testing.T) {
+	tests := []struct {
+		name                  string
+		seed                  []byte
+		path                  []uint32
+		wantParentFingerprint []byte
+		wantChainCode         []byte
+		wantPrivateKey        []byte
+		wantPublicKey         []byte
+	}{
+		{
+			"vector-1-m",
+			encodingtest.MustDecodeHex("000102030405060708090a0b0c0d0e0f"),
+			[]uint32{},
+			encodingtest.MustDecodeHex("00000000"),
+			encodingtest.MustDecodeHex("beeb672fe4621673f722f38529c07392fecaa61015c80c34f29ce8b41b3cb6ea"),
+			encodingtest.MustDecodeHex("612091aaa12e22dd2abef664f8a01a82cae99ad7441b7ef8110424915c268bc2"),
+			encodingtest.MustDecodeHex("0266874dc6ade47b3ecd096745ca09bcd29638dd52c2c12117b11ed3e458cfa9e8"),
+		},
+		{
+			"vector-1-m/0H",
+			encodingtest.MustDecodeHex("000102030405060708090a0b0c0d0e0f"),
+			[]uint32{HardenedKeyStart},
+			encodingtest.MustDecodeHex("be6105b5"),
+			encodingtest.MustDecodeHex("3460cea53e6a6bb5fb391eeef3237ffd8724bf0a40e94943c98b83825342ee11"),
+			encodingtest.MustDecodeHex("6939694369114c67917a182c59ddb8cafc3004e63ca5d3b84403ba8613debc0c"),
+			encodingtest.MustDecodeHex("0384610f5ecffe8fda089363a41f56a5c7ffc1d81b59a612d0d649b2d22355590c"),
+		},
+		{
+			"vector-1-m/0H/1",
+			encodingtest.MustDecodeHex("000102030405060708090a0b0c0d0e0f"),
+			[]uint32{HardenedKeyStart, 1},
+			encodingtest.MustDecodeHex("9b02312f"),
+			encodingtest.MustDecodeHex("4187afff1aafa8445010097fb99d23aee9f599450c7bd140b6826ac22ba21d0c"),
+			encodingtest.MustDecodeHex("284e9d38d07d21e4e281b645089a94f4cf5a5a81369acf151a1c3a57f18b2129"),
+			encodingtest.MustDecodeHex("03526c63f8d0b4bbbf9c80df553fe66742df4676b241dabefdef67733e070f6844"),
+		},
+		{
+			"vector-1-m/0H/1/2H/2/1000000000",
+			encodingtest.MustDecodeHex("000102030405060708090a0b0c0d0e0f"),
+			[]uint32{HardenedKeyStart, 1, HardenedKeyStart + 2, 2, 1000000000},
+			encodingtest.MustDecodeHex("8b2b5c4b"),
+			encodingtest.MustDecodeHex("b9b7b82d326bb9cb5b5b121066feea4eb93d5241103c9e7a18aad40f1dde8059"),
+			encodingtest.MustDecodeHex("21c4f269ef0a5fd1badf47eeacebeeaa3de22eb8e5b0adcd0f27dd99d34d0119"),
+			encodingtest.MustDecodeHex("02216cd26d31147f72427a453c443ed2cde8a1e53c9cc44e5ddf739725413fe3f4"),
+		},
+		{
+			"derivation-retry-m/28578H",
+			encodingtest.MustDecodeHex("000102030405060708090a0b0c0d0e0f"),
+			[]uint32{HardenedKeyStart + 28578},
+			encodingtest.MustDecodeHex("be6105b5"),
+			encodingtest.MustDecodeHex("e94c8ebe30c2250a14713212f6449b20f3329105ea15b652ca5bdfc68f6c65c2"),
+			encodingtest.MustDecodeHex("06f0db126f023755d0b8d86d4591718a5210dd8d024e3e14b6159d63f53aa669"),
+			encodingtest.MustDecodeHex("02519b5554a4872e8c9c1c847115363051ec43e93400e030ba3c36b52a3e70a5b7"),
+		},
+		{
+			"derivation-retry-m/28578H/33941",
+			encodingtest.MustDecodeHex("000102030405060708090a0b0c0d0e0f"),
+			[]uint32{HardenedKeyStart + 28578, 33941},
+			encodingtest.MustDecodeHex("3e2b7bc6"),
+			encodingtest.MustDecodeHex("9e87fe95031f14736774cd82f25fd885065cb7c358c1edf813c72af535e83071"),
+			encodingtest.MustDecodeHex("092154eed4af83e078ff9b84322015aefe5769e31270f62c3f66c33888335f3a"),
+			encodingtest.MustDecodeHex("0235bfee614c0d5b2cae260000bb1d0d84b270099ad790022c1ae0b2e782efe120"),
+		},
+		{
+			"seed-retry-m",
+			encodingtest.MustDecodeHex("a7305bc8df8d0951f0cb224c0e95d7707cbdf2c6ce7e8d481fec69c7ff5e9446"),
+			[]uint32{},
+			encodingtest.MustDecodeHex("00000000"),
+			encodingtest.MustDecodeHex("7762f9729fed06121fd13f326884c82f59aa95c57ac492ce8c9654e60efd130c"),
+			encodingtest.MustDecodeHex("3b8c18469a4634517d6d0b65448f8e6c62091b45540a1743c5846be55d47d88f"),
+			encodingtest.MustDecodeHex("0383619fadcde31063d8c5cb00dbfe1713f3e6fa169d8541a798752a1c1ca0cb20"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key, err := NewExtendedPrivateKeyFromSeed(tt.seed)
+			require.NoError(t, err)
+
+			for _, index := range tt.path {
+				child, err := key.Derive(index)
+				require.NoError(t, err)
+				key = child.(*ExtendedPrivateKey)
+			}
+
+			assert.Equal(t, tt.wantParentFingerprint, key.ParentFingerprint())
+			assert.Equal(t, tt.wantChainCode, key.ChainCode())
+			assert.Equal(t, tt.wantPrivateKey, key.PrivateKey().Bytes())
+			assert.Equal(t, tt.wantPublicKey, key.PrivateKey().PublicKey().Bytes())
+		})
+	}
+}
+
+func TestNewExtendedPrivateKeyFromSeed(t *testing.T) {
+	tests := []struct {
+		name    string
+		seed    []byte
+		wantErr error
+	}{
+		{
+			"success-64",
+			make([]byte, 64),
+			nil,
+		},
+		{
+			"err-short",
+			make([]byte, 15),
+			ErrInvalidSeedLength,
+		},
+		{
+			"err-long",
+			make([]byte, 65),
+			ErrInvalidSeedLength,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewExtendedPrivateKeyFromSeed(tt.seed)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestExtendedPrivateKeyFromBytes(t *testing.T) {
+	key, err := NewExtendedPrivateKeyFromSeed(encodingtest.MustDecodeHex("000102030405060708090a0b0c0d0e0f"))
+	require.NoError(t, err)
+	child, err := key.Derive(HardenedKeyStart)
+	require.NoError(t, err)
+
+	got, err := ExtendedPrivateKeyFromBytes(child.Bytes())
+	require.NoError(t, err)
+	assert.Equal(t, child.Bytes(), got.Bytes())
+	assert.Equal(t, child.PrivateKey().PublicKey(), got.PrivateKey().PublicKey())
+
+	_, err = ExtendedPrivateKeyFromBytes(child.Bytes()[1:])
+	assert.Error(t, err)
+
+	zeroKey := child.Bytes()
+	copy(zeroKey[42:], make([]byte, 32))
+	_, err = ExtendedPrivateKeyFromBytes(zeroKey)
+	assert.Equal(t, ErrInvalidExtendedKey, err)
+}
@@ -0,0 +1,109 @@
+package secp256r1
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"math/big"
+
+	"github.com/mailchain/go-crypto"
+	"github.com/mailchain/go-crypto/internal/hdkey"
+)
+
+// ExtendedPublicKey is a SLIP-0010 hierarchical deterministic public key based on the p256 curve.
+// Only non-hardened children can be derived from an extended public key.
+type ExtendedPublicKey struct {
+	meta hdkey.Metadata
+	key  PublicKey
+}
+
+// ExtendedPublicKeyFromBytes creates an extended public key from the 74 byte serialization returned by Bytes.
+func ExtendedPublicKeyFromBytes(in []byte) (*ExtendedPublicKey, error) {
+	meta, keyData, err := hdkey.Deserialize(in, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := PublicKeyFromBytes(keyData)
+	if err != nil || key.(*PublicKey).Key.X == nil {
+		return nil, ErrInvalidExtendedKey
+	}
+
+	return &ExtendedPublicKey{meta: meta, key: *key.(*PublicKey)}, nil
+}
+
+// Bytes returns the BIP-32 layout of the extended public key without the version prefix,
+// SLIP-0010 does not define version bytes for p256.
+func (k ExtendedPublicKey) Bytes() []byte {
+	return k.meta.Serialize(nil, k.key.Bytes())
+}
+
+// PublicKey returns the public key.
+func (k ExtendedPublicKey) PublicKey() crypto.PublicKey {
+	return &k.key
+}
+
+// ChainCode returns the chain code used to derive child keys.
+func (k ExtendedPublicKey) ChainCode() []byte {
+	return k.meta.ChainCode
+}
+
+// Depth returns the number of derivations from the master key, the master key has a depth of 0.
+func (k ExtendedPublicKey) Depth() uint8 {
+	return k.meta.Depth
+}
+
+// ChildNumber returns the index that was used to derive this key from its parent.
+func (k ExtendedPublicKey) ChildNumber() uint32 {
+	return k.meta.ChildNumber
+}
+
+// Fingerprint returns the fingerprint of this key, it is the parent fingerprint of its children.
+func (k ExtendedPublicKey) Fingerprint() []byte {
+	return hdkey.Fingerprint(k.key.Bytes())
+}
+
+// ParentFingerprint returns the fingerprint of the parent key, the master key has a parent fingerprint of 0x00000000.
+func (k ExtendedPublicKey) ParentFingerprint() []byte {
+	return k.meta.ParentFingerprint
+}
+
+// Derive the non-hardened child extended public key at index.
+// ErrDeriveHardenedFromPublic is returned when index is equal to or greater than HardenedKeyStart.
+func (k ExtendedPublicKey) Derive(index uint32) (crypto.ExtendedPublicKey, error) {
+	return k.derive(index)
+}
+
+func (k ExtendedPublicKey) derive(index uint32) (*ExtendedPublicKey, error) {
+	if hdkey.IsHardened(index) {
+		return nil, ErrDeriveHardenedFromPublic
+	}
+
+	curve := elliptic.P256()
+	parentPublicKey := k.key.Bytes()
+
+	il, ir := hdkey.HMACSHA512(k.meta.ChainCode, append(append([]byte{}, parentPublicKey...), hdkey.Uint32Bytes(index)...))
+
+	var childX, childY *big.Int
+	for {
+		if new(big.Int).SetBytes(il).Cmp(curve.Params().N) < 0 {
+			ilX, ilY := curve.ScalarBaseMult(il)
+			childX, childY = curve.Add(ilX, ilY, k.key.Key.X, k.key.Key.Y)
+
+			if childX.Sign() != 0 || childY.Sign() != 0 {
+				break
+			}
+		}
+
+		il, ir = hdkey.HMACSHA512(k.meta.ChainCode, retryData(ir, index))
+	}
+
+	childMeta, err := k.meta.Child(parentPublicKey, index, ir)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ExtendedPublicKey{
+		meta: childMeta,
+		key:  PublicKey{Key: ecdsa.PublicKey{Curve: curve, X: childX, Y: childY}},
+	}, nil
+}
@@ -0,0 +1,68 @@
+package secp256r1
+
+import (
+	"testing"
+
+	"github.com/mailchain/go-encoding/encodingtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtendedPublicKey_MatchesPrivateDerivation(t *testing.T) {
+	master, err := NewExtendedPrivateKeyFromSeed(encodingtest.MustDecodeHex("000102030405060708090a0b0c0d0e0f"))
+	require.NoError(t, err)
+	hardened, err := master.Derive(HardenedKeyStart)
+	require.NoError(t, err)
+
+	parentPub, err := hardened.ExtendedPublicKey()
+	require.NoError(t, err)
+
+	for _, index := range []uint32{0, 1, 2, 33941, HardenedKeyStart - 1} {
+		privChild, err := hardened.Derive(index)
+		require.NoError(t, err)
+
+		pubChild, err := parentPub.Derive(index)
+		require.NoError(t, err)
+
+		privChildPub, err := privChild.ExtendedPublicKey()
+		require.NoError(t, err)
+
+		assert.Equal(t, privChildPub.Bytes(), pubChild.Bytes())
+	}
+}
+
+func TestExtendedPublicKey_Derive(t *testing.T) {
+	master, err := NewExtendedPrivateKeyFromSeed(encodingtest.MustDecodeHex("000102030405060708090a0b0c0d0e0f"))
+	require.NoError(t, err)
+	hardened, err := master.Derive(HardenedKeyStart)
+	require.NoError(t, err)
+	pub, err := hardened.ExtendedPublicKey()
+	require.NoError(t, err)
+
+	child, err := pub.Derive(1)
+	require.NoError(t, err)
+	assert.Equal(t, encodingtest.MustDecodeHex("03526c63f8d0b4bbbf9c80df553fe66742df4676b241dabefdef67733e070f6844"), child.PublicKey().Bytes())
+	assert.Equal(t, encodingtest.MustDecodeHex("9b02312f"), child.(*ExtendedPublicKey).ParentFingerprint())
+
+	_, err = pub.Derive(HardenedKeyStart)
+	assert.Equal(t, ErrDeriveHardenedFromPublic, err)
+}
+
+func TestExtendedPublicKeyFromBytes(t *testing.T) {
+	master, err := NewExtendedPrivateKeyFromSeed(encodingtest.MustDecodeHex("000102030405060708090a0b0c0d0e0f"))
+	require.NoError(t, err)
+	pub, err := master.ExtendedPublicKey()
+	require.NoError(t, err)
+
+	got, err := ExtendedPublicKeyFromBytes(pub.Bytes())
+	require.NoError(t, err)
+	assert.Equal(t, pub.Bytes(), got.Bytes())
+
+	_, err = ExtendedPublicKeyFromBytes(pub.Bytes()[2:])
+	assert.Error(t, err)
+
+	invalidPoint := pub.Bytes()
+	invalidPoint[41] = 0x05
+	_, err = ExtendedPublicKeyFromBytes(invalidPoint)
+	assert.Equal(t, ErrInvalidExtendedKey, err)
+}
@@ -4,7 +4,6 @@ import (
 	"crypto/ecdsa"
 	"crypto/elliptic"
 	"crypto/rand"
-	"fmt"
 	"io"
 	"math/big"
 
@@ -12,6 +11,10 @@ import (
 	"github.com/mailchain/go-crypto"
 )
 
+// ErrUnusableSeed is returned when the private key bytes fall outside of the valid range for p256 private keys,
+// the caller must choose another seed.
+var ErrUnusableSeed = crypto.ErrUnusableSeed
+
 // PrivateKey based on the p256 curve
 type PrivateKey struct {
 	key  ecdsa.PrivateKey
@@ -92,9 +95,8 @@ func ecNormalizeSignature(r, s *big.Int, c elliptic.Curve) (*big.Int, *big.Int)
 
 func toECDSA(pkBytes []byte) (*ecdsa.PrivateKey, error) {
 	k := new(big.Int).SetBytes(pkBytes)
-	curveOrder := elliptic.P256().Params().N
-	if k.Cmp(curveOrder) >= 0 {
-		return nil, fmt.Errorf("invalid private key for curve Nist P256")
+	if !isValidScalar(k) {
+		return nil, ErrUnusableSeed
 	}
 
 	priv := ecdsa.PrivateKey{
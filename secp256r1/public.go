@@ -16,7 +16,12 @@ type PublicKey struct {
 }
 
 // Verify verifies whether sig is a valid signature of message.
+// Signatures with a high S value are accepted, use VerifyStrict when signatures must not be malleable.
 func (pk PublicKey) Verify(message, sig []byte) bool {
+	if len(sig) != SignatureSize {
+		return false
+	}
+
 	r := new(big.Int).SetBytes(sig[:32])
 	s := new(big.Int).SetBytes(sig[32:])
 	return ecdsa.Verify(&pk.Key, message, r, s)
@@ -34,6 +39,9 @@ func PublicKeyFromBytes(keyBytes []byte) (crypto.PublicKey, error) {
 	}
 	key := ecdsa.PublicKey{Curve: elliptic.P256()}
 	key.X, key.Y = elliptic.UnmarshalCompressed(elliptic.P256(), keyBytes)
+	if key.X == nil {
+		return nil, fmt.Errorf("public key is not a compressed point on the curve")
+	}
 
 	return &PublicKey{Key: key}, nil
 }
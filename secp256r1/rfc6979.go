@@ -0,0 +1,121 @@
+package secp256r1
+
+import (
+	"crypto/ecdsa"
+	"crypto/hmac"
+	"crypto/sha256"
+	"hash"
+	"math/big"
+)
+
+// SignDeterministic signs the message with the private key using a deterministic nonce generated with HMAC-SHA256 as
+// defined in RFC 6979, the same key and message always result in the same signature and no randomness is used.
+// The message is expected to be a SHA-256 digest, the signature is S normalized the same as Sign.
+func (pk PrivateKey) SignDeterministic(message []byte) (signature []byte, err error) {
+	r, s := signRFC6979(&pk.key, message)
+	r, s = ecNormalizeSignature(r, s, pk.key.Curve)
+	buf := make([]byte, 64)
+	r.FillBytes(buf[:32])
+	s.FillBytes(buf[32:])
+
+	return buf, nil
+}
+
+// signRFC6979 returns the ECDSA signature of digest using the nonce from rfc6979Nonces, the next nonce is used
+// when r or s is zero as described in https://www.rfc-editor.org/rfc/rfc6979#section-3.4.
+func signRFC6979(key *ecdsa.PrivateKey, digest []byte) (r, s *big.Int) {
+	curve := key.Curve
+	n := curve.Params().N
+	e := bits2int(digest, n.BitLen())
+	nextNonce := rfc6979Nonces(sha256.New, key.D, digest, n)
+
+	for {
+		k := nextNonce()
+
+		x, _ := curve.ScalarBaseMult(k.FillBytes(make([]byte, (n.BitLen()+7)/8)))
+		r = new(big.Int).Mod(x, n)
+
+		if r.Sign() == 0 {
+			continue
+		}
+
+		// s = k⁻¹(e + rd) mod n
+		s = new(big.Int).Mul(r, key.D)
+		s.Add(s, e)
+		s.Mul(s, new(big.Int).ModInverse(k, n))
+		s.Mod(s, n)
+
+		if s.Sign() != 0 {
+			return r, s
+		}
+	}
+}
+
+// rfc6979Nonces returns a function that generates the sequence of candidate nonces for the private key x and digest
+// as described in https://www.rfc-editor.org/rfc/rfc6979#section-3.2.
+func rfc6979Nonces(newHash func() hash.Hash, x *big.Int, digest []byte, q *big.Int) func() *big.Int {
+	qlen := q.BitLen()
+	rolen := (qlen + 7) / 8
+	hlen := newHash().Size()
+
+	mac := func(key []byte, data ...[]byte) []byte {
+		m := hmac.New(newHash, key)
+		for _, d := range data {
+			m.Write(d)
+		}
+
+		return m.Sum(nil)
+	}
+
+	privateKey := x.FillBytes(make([]byte, rolen))
+	h1 := new(big.Int).Mod(bits2int(digest, qlen), q).FillBytes(make([]byte, rolen))
+
+	// step b and c
+	v := make([]byte, hlen)
+	for i := range v {
+		v[i] = 0x01
+	}
+
+	k := make([]byte, hlen)
+
+	// step d to g
+	k = mac(k, v, []byte{0x00}, privateKey, h1)
+	v = mac(k, v)
+	k = mac(k, v, []byte{0x01}, privateKey, h1)
+	v = mac(k, v)
+
+	first := true
+
+	return func() *big.Int {
+		for {
+			if !first {
+				k = mac(k, v, []byte{0x00})
+				v = mac(k, v)
+			}
+
+			first = false
+
+			// step h
+			t := make([]byte, 0, rolen)
+			for len(t) < rolen {
+				v = mac(k, v)
+				t = append(t, v...)
+			}
+
+			nonce := bits2int(t, qlen)
+			if nonce.Sign() > 0 && nonce.Cmp(q) < 0 {
+				return nonce
+			}
+		}
+	}
+}
+
+// bits2int converts the leftmost qlen bits of b to an integer as described in https://www.rfc-editor.org/rfc/rfc6979#section-2.3.2.
+func bits2int(b []byte, qlen int) *big.Int {
+	v := new(big.Int).SetBytes(b)
+	if blen := len(b) * 8; blen > qlen {
+		v.Rsh(v, uint(blen-qlen))
+	}
+
+	return v
+}
@@ -0,0 +1,72 @@
+package secp256r1
+
+import (
+	"crypto/sha256"
+	"math/big"
+	"testing"
+
+	"github.com/mailchain/go-encoding/encodingtest"
+	"github.com/stretchr/testify/assert"
+)
+
+// https://www.rfc-editor.org/rfc/rfc6979#appendix-A.2.5
+var rfc6979PrivateKey = encodingtest.MustDecodeHex("C9AFA9D845BA75166B5C215767B1D6934E50C3DB36E89B127B8A622B120F6721") //nolint: gochecknoglobals
+
+func TestSignDeterministic_RFC6979Vectors(t *testing.T) {
+	tests := []struct {
+		name    string
+		message string
+		wantK   string
+		wantR   string
+		wantS   string
+	}{
+		{
+			"sample",
+			"sample",
+			"A6E3C57DD01ABE90086538398355DD4C3B17AA873382B0F24D6129493D8AAD60",
+			"EFD48B2AACB6A8FD1140DD9CD45E81D69D2C877B56AAF991C34D0EA84EAF3716",
+			"F7CB1C942D657C41D436C7A1B6E29F65F3E900DBB9AFF4064DC4AB2F843ACDA8",
+		},
+		{
+			"test",
+			"test",
+			"D16B6AE827F17175E040871A1C7EC3500192C4C92677336EC2537ACAEE0008E0",
+			"F1ABB023518351CD71D881567B1EA663ED3EFCF6C5132B354F28D3B0B7D38367",
+			"019F4113742A2B14BD25926B49C649155F267E60D3814B4C0CC84250E46F0083",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key, err := PrivateKeyFromBytes(rfc6979PrivateKey)
+			if !assert.NoError(t, err) {
+				t.FailNow()
+			}
+
+			assert.Equal(t, encodingtest.MustDecodeHex("0360FED4BA255A9D31C961EB74C6356D68C049B8923B61FA6CE669622E60F29FB6"), key.PublicKey().Bytes())
+
+			digest := sha256.Sum256([]byte(tt.message))
+			k := rfc6979Nonces(sha256.New, key.key.D, digest[:], key.key.Curve.Params().N)()
+			assert.Equal(t, encodingtest.MustDecodeHex(tt.wantK), k.FillBytes(make([]byte, 32)))
+
+			// the RFC 6979 vectors are not S normalized
+			wantS := new(big.Int).SetBytes(encodingtest.MustDecodeHex(tt.wantS))
+			_, wantS = ecNormalizeSignature(big.NewInt(0), wantS, key.key.Curve)
+			want := append(encodingtest.MustDecodeHex(tt.wantR), wantS.FillBytes(make([]byte, 32))...)
+
+			got, err := key.SignDeterministic(digest[:])
+			assert.NoError(t, err)
+			assert.Equal(t, want, got)
+			assert.True(t, key.PublicKey().Verify(digest[:], got))
+
+			again, err := key.SignDeterministic(digest[:])
+			assert.NoError(t, err)
+			assert.Equal(t, got, again)
+		})
+	}
+}
+
+func TestBits2Int(t *testing.T) {
+	assert.Equal(t, big.NewInt(0x0102), bits2int([]byte{0x01, 0x02}, 256))
+	assert.Equal(t, big.NewInt(0x01), bits2int([]byte{0x01, 0x02}, 8))
+	assert.Equal(t, big.NewInt(0x0810), bits2int([]byte{0x81, 0x02}, 12))
+}
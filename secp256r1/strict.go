@@ -0,0 +1,27 @@
+package secp256r1
+
+import (
+	"crypto/ecdsa"
+	"crypto/sha256"
+
+	"github.com/mailchain/go-crypto/internal/ecdsasig"
+)
+
+// VerifyStrict verifies whether sig is a valid signature of message, only accepting a single encoding of each signature.
+// It is the recommended verification where every party must agree on which signatures are valid, for example consensus.
+// The message must be a 32 byte SHA-256 digest, the signature must be the 64 byte r || s form with r and s between 1
+// and the curve order - 1 and a low S value as created by Sign and SignDeterministic.
+func (pk PublicKey) VerifyStrict(message, sig []byte) bool {
+	if len(message) != sha256.Size || pk.Key.X == nil || pk.Key.Y == nil || !pk.Key.Curve.IsOnCurve(pk.Key.X, pk.Key.Y) {
+		return false
+	}
+
+	n := pk.Key.Curve.Params().N
+
+	r, s, err := ecdsasig.ParseFixed(sig, n)
+	if err != nil || !ecdsasig.IsLowS(s, n) {
+		return false
+	}
+
+	return ecdsa.Verify(&pk.Key, message, r, s)
+}
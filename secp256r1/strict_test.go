@@ -0,0 +1,119 @@
+package secp256r1
+
+import (
+	"crypto/elliptic"
+	"crypto/sha256"
+	"math/big"
+	"testing"
+
+	"github.com/mailchain/go-crypto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPublicKey_VerifyStrict(t *testing.T) {
+	key, err := PrivateKeyFromBytes(rfc6979PrivateKey)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	publicKey := *key.PublicKey().(*PublicKey)
+	digest := sha256.Sum256([]byte("message"))
+
+	sig, err := key.SignDeterministic(digest[:])
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	n := elliptic.P256().Params().N
+	highS := append(append([]byte{}, sig[:32]...), new(big.Int).Sub(n, new(big.Int).SetBytes(sig[32:])).FillBytes(make([]byte, 32))...)
+	rOutOfRange := append(new(big.Int).Add(new(big.Int).SetBytes(sig[:32]), n).FillBytes(make([]byte, 33))[1:], sig[32:]...)
+
+	tests := []struct {
+		name          string
+		pk            PublicKey
+		message       []byte
+		sig           []byte
+		want          bool
+		wantNonStrict bool
+	}{
+		{
+			"valid",
+			publicKey,
+			digest[:],
+			sig,
+			true,
+			true,
+		},
+		{
+			"err-high-s",
+			publicKey,
+			digest[:],
+			highS,
+			false,
+			true,
+		},
+		{
+			"err-long-message",
+			publicKey,
+			append(append([]byte{}, digest[:]...), 0),
+			sig,
+			false,
+			true,
+		},
+		{
+			"err-r-out-of-range",
+			publicKey,
+			digest[:],
+			rOutOfRange,
+			false,
+			false,
+		},
+		{
+			"err-wrong-message",
+			publicKey,
+			make([]byte, 32),
+			sig,
+			false,
+			false,
+		},
+		{
+			"err-short-signature",
+			publicKey,
+			digest[:],
+			sig[:31],
+			false,
+			false,
+		},
+		{
+			"err-long-signature",
+			publicKey,
+			digest[:],
+			append(append([]byte{}, sig...), 0),
+			false,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.pk.VerifyStrict(tt.message, tt.sig))
+			assert.Equal(t, tt.want, crypto.VerifyStrict(tt.pk, tt.message, tt.sig))
+			assert.Equal(t, tt.wantNonStrict, tt.pk.Verify(tt.message, tt.sig))
+		})
+	}
+
+	assert.False(t, PublicKey{}.VerifyStrict(digest[:], sig))
+}
+
+func TestPublicKeyFromBytes_NotOnCurve(t *testing.T) {
+	key, err := PrivateKeyFromBytes(rfc6979PrivateKey)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	invalid := key.PublicKey().Bytes()
+	invalid[0] = 0x05
+
+	got, err := PublicKeyFromBytes(invalid)
+	assert.Error(t, err)
+	assert.Nil(t, got)
+}
@@ -0,0 +1,65 @@
+package slip39
+
+import (
+	"crypto/sha256"
+
+	"golang.org/x/crypto/pbkdf2"
+)
+
+const (
+	baseIterationCount = 10000
+	roundCount         = 4
+)
+
+func salt(identifier int, extendable bool) []byte {
+	if extendable {
+		return []byte{}
+	}
+
+	return append(append([]byte{}, customizationStringOriginal...), byte(identifier>>8), byte(identifier))
+}
+
+func roundFunction(i int, passphrase []byte, iterationExponent int, salt, r []byte) []byte {
+	return pbkdf2.Key(
+		append([]byte{byte(i)}, passphrase...),
+		append(append([]byte{}, salt...), r...),
+		(baseIterationCount<<iterationExponent)/roundCount,
+		len(r),
+		sha256.New,
+	)
+}
+
+// encrypt the master secret with the passphrase using the four round Feistel network defined in SLIP-39.
+func encrypt(masterSecret, passphrase []byte, iterationExponent, identifier int, extendable bool) []byte {
+	half := len(masterSecret) / 2
+	l, r := masterSecret[:half], masterSecret[half:]
+	s := salt(identifier, extendable)
+
+	for i := 0; i < roundCount; i++ {
+		l, r = r, xor(l, roundFunction(i, passphrase, iterationExponent, s, r))
+	}
+
+	return append(append([]byte{}, r...), l...)
+}
+
+// decrypt the encrypted master secret with the passphrase, the rounds of encrypt are applied in reverse order.
+func decrypt(encryptedMasterSecret, passphrase []byte, iterationExponent, identifier int, extendable bool) []byte {
+	half := len(encryptedMasterSecret) / 2
+	l, r := encryptedMasterSecret[:half], encryptedMasterSecret[half:]
+	s := salt(identifier, extendable)
+
+	for i := roundCount - 1; i >= 0; i-- {
+		l, r = r, xor(l, roundFunction(i, passphrase, iterationExponent, s, r))
+	}
+
+	return append(append([]byte{}, r...), l...)
+}
+
+func xor(a, b []byte) []byte {
+	out := make([]byte, len(a))
+	for i := range a {
+		out[i] = a[i] ^ b[i]
+	}
+
+	return out
+}
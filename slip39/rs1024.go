@@ -0,0 +1,68 @@
+package slip39
+
+const checksumLengthWords = 3
+
+var (
+	customizationStringOriginal   = []byte("shamir")            //nolint: gochecknoglobals
+	customizationStringExtendable = []byte("shamir_extendable") //nolint: gochecknoglobals
+)
+
+func customizationString(extendable bool) []byte {
+	if extendable {
+		return customizationStringExtendable
+	}
+
+	return customizationStringOriginal
+}
+
+// rs1024Polymod is the Reed-Solomon code over GF(1024) used by the SLIP-39 checksum.
+func rs1024Polymod(values []int) int {
+	gen := [...]int{
+		0xe0e040, 0x1c1c080, 0x3838100, 0x7070200, 0xe0e0009,
+		0x1c0c2412, 0x38086c24, 0x3090fc48, 0x21b1f890, 0x3f3f120,
+	}
+
+	chk := 1
+	for _, v := range values {
+		b := chk >> 20
+		chk = (chk&0xfffff)<<10 ^ v
+
+		for i := 0; i < 10; i++ {
+			if (b>>i)&1 == 1 {
+				chk ^= gen[i]
+			}
+		}
+	}
+
+	return chk
+}
+
+func rs1024CreateChecksum(data []int, extendable bool) []int {
+	values := customizationValues(extendable)
+	values = append(values, data...)
+	values = append(values, make([]int, checksumLengthWords)...)
+
+	polymod := rs1024Polymod(values) ^ 1
+	checksum := make([]int, checksumLengthWords)
+
+	for i := range checksum {
+		checksum[i] = (polymod >> (radixBits * (checksumLengthWords - 1 - i))) & (radix - 1)
+	}
+
+	return checksum
+}
+
+func rs1024VerifyChecksum(data []int, extendable bool) bool {
+	return rs1024Polymod(append(customizationValues(extendable), data...)) == 1
+}
+
+func customizationValues(extendable bool) []int {
+	custom := customizationString(extendable)
+	values := make([]int, len(custom))
+
+	for i, c := range custom {
+		values[i] = int(c)
+	}
+
+	return values
+}
@@ -0,0 +1,170 @@
+package slip39
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"io"
+)
+
+const (
+	maxShareCount     = 16
+	digestLengthBytes = 4
+	secretIndex       = 255
+	digestIndex       = 254
+)
+
+// expTable and logTable are the exponent and logarithm tables of GF(256) with the Rijndael polynomial
+// x^8 + x^4 + x^3 + x + 1 and generator 3.
+var expTable, logTable = gf256Tables() //nolint: gochecknoglobals
+
+func gf256Tables() (exp, log [256]int) {
+	poly := 1
+	for i := 0; i < 255; i++ {
+		exp[i] = poly
+		log[poly] = i
+
+		poly = (poly << 1) ^ poly
+		if poly&0x100 != 0 {
+			poly ^= 0x11b
+		}
+	}
+
+	return exp, log
+}
+
+type rawShare struct {
+	x    int
+	data []byte
+}
+
+// interpolate returns the value at x of the polynomial that passes through the shares using Lagrange interpolation.
+func interpolate(shares []rawShare, x int) ([]byte, error) {
+	seen := map[int]bool{}
+	for _, s := range shares {
+		if seen[s.x] {
+			return nil, ErrInvalidShares
+		}
+
+		if len(s.data) != len(shares[0].data) {
+			return nil, ErrInvalidShares
+		}
+
+		seen[s.x] = true
+	}
+
+	for _, s := range shares {
+		if s.x == x {
+			return append([]byte{}, s.data...), nil
+		}
+	}
+
+	logProd := 0
+	for _, s := range shares {
+		logProd += logTable[s.x^x]
+	}
+
+	result := make([]byte, len(shares[0].data))
+
+	for _, s := range shares {
+		logBasisEval := logProd - logTable[s.x^x]
+		for _, other := range shares {
+			logBasisEval -= logTable[s.x^other.x]
+		}
+
+		logBasisEval = ((logBasisEval % 255) + 255) % 255
+
+		for i, v := range s.data {
+			if v != 0 {
+				result[i] ^= byte(expTable[(logTable[v]+logBasisEval)%255])
+			}
+		}
+	}
+
+	return result, nil
+}
+
+func createDigest(randomData, sharedSecret []byte) []byte {
+	mac := hmac.New(sha256.New, randomData)
+	mac.Write(sharedSecret)
+
+	return mac.Sum(nil)[:digestLengthBytes]
+}
+
+func splitSecret(rand io.Reader, threshold, shareCount int, sharedSecret []byte) ([]rawShare, error) {
+	if threshold < 1 || threshold > shareCount || shareCount > maxShareCount {
+		return nil, ErrInvalidThreshold
+	}
+
+	shares := make([]rawShare, 0, shareCount)
+
+	if threshold == 1 {
+		for i := 0; i < shareCount; i++ {
+			shares = append(shares, rawShare{x: i, data: append([]byte{}, sharedSecret...)})
+		}
+
+		return shares, nil
+	}
+
+	randomShareCount := threshold - 2
+
+	for i := 0; i < randomShareCount; i++ {
+		data, err := randomBytes(rand, len(sharedSecret))
+		if err != nil {
+			return nil, err
+		}
+
+		shares = append(shares, rawShare{x: i, data: data})
+	}
+
+	randomPart, err := randomBytes(rand, len(sharedSecret)-digestLengthBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	baseShares := append(append([]rawShare{}, shares...),
+		rawShare{x: digestIndex, data: append(createDigest(randomPart, sharedSecret), randomPart...)},
+		rawShare{x: secretIndex, data: sharedSecret},
+	)
+
+	for i := randomShareCount; i < shareCount; i++ {
+		data, err := interpolate(baseShares, i)
+		if err != nil {
+			return nil, err
+		}
+
+		shares = append(shares, rawShare{x: i, data: data})
+	}
+
+	return shares, nil
+}
+
+func recoverSecret(threshold int, shares []rawShare) ([]byte, error) {
+	if threshold == 1 {
+		return append([]byte{}, shares[0].data...), nil
+	}
+
+	sharedSecret, err := interpolate(shares, secretIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	digestShare, err := interpolate(shares, digestIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	if !hmac.Equal(digestShare[:digestLengthBytes], createDigest(digestShare[digestLengthBytes:], sharedSecret)) {
+		return nil, ErrInvalidDigest
+	}
+
+	return sharedSecret, nil
+}
+
+func randomBytes(rand io.Reader, length int) ([]byte, error) {
+	out := make([]byte, length)
+	if _, err := io.ReadFull(rand, out); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
@@ -0,0 +1,118 @@
+package slip39
+
+import (
+	"math/big"
+)
+
+const (
+	idLengthBits           = 15
+	extendableFlagBits     = 1
+	iterationExpLengthBits = 4
+	idExpLengthWords       = 2
+	metadataLengthWords    = idExpLengthWords + 2 + checksumLengthWords
+	minStrengthBits        = 128
+	minMnemonicLengthWords = metadataLengthWords + (minStrengthBits+radixBits-1)/radixBits
+)
+
+// share is a single decoded SLIP-39 mnemonic.
+type share struct {
+	identifier        int
+	extendable        bool
+	iterationExponent int
+	groupIndex        int
+	groupThreshold    int
+	groupCount        int
+	memberIndex       int
+	memberThreshold   int
+	value             []byte
+}
+
+func (s share) mnemonic() string {
+	idExp := s.identifier<<(extendableFlagBits+iterationExpLengthBits) | s.iterationExponent
+	if s.extendable {
+		idExp |= 1 << iterationExpLengthBits
+	}
+
+	params := s.groupIndex<<16 | (s.groupThreshold-1)<<12 | (s.groupCount-1)<<8 | s.memberIndex<<4 | (s.memberThreshold - 1)
+	valueWordCount := (len(s.value)*8 + radixBits - 1) / radixBits
+
+	data := intToIndexes(big.NewInt(int64(idExp)), idExpLengthWords)
+	data = append(data, intToIndexes(big.NewInt(int64(params)), 2)...)
+	data = append(data, intToIndexes(new(big.Int).SetBytes(s.value), valueWordCount)...)
+	data = append(data, rs1024CreateChecksum(data, s.extendable)...)
+
+	return indexesToWords(data)
+}
+
+func decodeShare(mnemonic string) (*share, error) {
+	data, err := wordsToIndexes(mnemonic)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(data) < minMnemonicLengthWords {
+		return nil, ErrInvalidMnemonicLength
+	}
+
+	paddingLength := (radixBits * (len(data) - metadataLengthWords)) % 16
+	if paddingLength > 8 {
+		return nil, ErrInvalidPadding
+	}
+
+	idExp := int(intFromIndexes(data[:idExpLengthWords]).Int64())
+	s := &share{
+		identifier:        idExp >> (extendableFlagBits + iterationExpLengthBits),
+		extendable:        (idExp>>iterationExpLengthBits)&1 == 1,
+		iterationExponent: idExp & (1<<iterationExpLengthBits - 1),
+	}
+
+	if !rs1024VerifyChecksum(data, s.extendable) {
+		return nil, ErrInvalidChecksum
+	}
+
+	params := int(intFromIndexes(data[idExpLengthWords : idExpLengthWords+2]).Int64())
+	s.groupIndex = params >> 16 & 0xf
+	s.groupThreshold = params>>12&0xf + 1
+	s.groupCount = params>>8&0xf + 1
+	s.memberIndex = params >> 4 & 0xf
+	s.memberThreshold = params&0xf + 1
+
+	if s.groupCount < s.groupThreshold {
+		return nil, ErrInvalidGroupThreshold
+	}
+
+	valueData := data[idExpLengthWords+2 : len(data)-checksumLengthWords]
+	valueByteCount := (radixBits*len(valueData) - paddingLength) / 8
+	value := intFromIndexes(valueData)
+
+	if value.BitLen() > valueByteCount*8 {
+		return nil, ErrInvalidPadding
+	}
+
+	s.value = value.FillBytes(make([]byte, valueByteCount))
+
+	return s, nil
+}
+
+func intToIndexes(value *big.Int, length int) []int {
+	indexes := make([]int, length)
+	mask := big.NewInt(radix - 1)
+	v := new(big.Int).Set(value)
+
+	for i := length - 1; i >= 0; i-- {
+		indexes[i] = int(new(big.Int).And(v, mask).Int64())
+		v.Rsh(v, radixBits)
+	}
+
+	return indexes
+}
+
+func intFromIndexes(indexes []int) *big.Int {
+	value := new(big.Int)
+	for _, index := range indexes {
+		value.Lsh(value, radixBits)
+		value.Or(value, big.NewInt(int64(index)))
+	}
+
+	return value
+}
@@ -0,0 +1,215 @@
+// Package slip39 splits a master secret into Shamir mnemonic shares and recovers it as defined in SLIP-39,
+// see https://github.com/satoshilabs/slips/blob/master/slip-0039.md.
+//
+// The master secret is encrypted with a passphrase then split into groups of member shares,
+// it is recovered when the group threshold of groups each provide their member threshold of shares.
+package slip39
+
+import (
+	"errors"
+	"io"
+)
+
+// MaxIterationExponent is the largest iteration exponent that can be encoded in a share.
+const MaxIterationExponent = 1<<iterationExpLengthBits - 1
+
+var (
+	// ErrUnknownWord is returned when a mnemonic contains a word that is not in the SLIP-39 wordlist.
+	ErrUnknownWord = errors.New("word is not in the slip39 wordlist")
+	// ErrInvalidMnemonicLength is returned when a mnemonic has too few words.
+	ErrInvalidMnemonicLength = errors.New("invalid mnemonic length")
+	// ErrInvalidPadding is returned when the padding of the share value is invalid.
+	ErrInvalidPadding = errors.New("invalid mnemonic padding")
+	// ErrInvalidChecksum is returned when the checksum of a mnemonic does not match.
+	ErrInvalidChecksum = errors.New("invalid mnemonic checksum")
+	// ErrInvalidGroupThreshold is returned when the group threshold is less than 1 or greater than the group count.
+	ErrInvalidGroupThreshold = errors.New("group threshold must be between 1 and the group count")
+	// ErrInvalidThreshold is returned when a member threshold is invalid for the member count.
+	ErrInvalidThreshold = errors.New("invalid member threshold, multiple member shares with a threshold of 1 are not allowed")
+	// ErrInvalidMasterSecretLength is returned when the master secret is shorter than 128 bits or has an odd length.
+	ErrInvalidMasterSecretLength = errors.New("master secret must be at least 128 bits and an even number of bytes")
+	// ErrInvalidIterationExponent is returned when the iteration exponent can not be encoded in a share.
+	ErrInvalidIterationExponent = errors.New("iteration exponent must be between 0 and 15")
+	// ErrInvalidPassphrase is returned when the passphrase contains characters other than printable ASCII.
+	ErrInvalidPassphrase = errors.New("passphrase must only contain printable ASCII characters")
+	// ErrEmptyShares is returned when no mnemonics are provided for recovery.
+	ErrEmptyShares = errors.New("the set of shares is empty")
+	// ErrMismatchedShares is returned when the mnemonics do not belong to the same backup.
+	ErrMismatchedShares = errors.New("mnemonics must have the same identifier, iteration exponent, group threshold and group count")
+	// ErrInvalidGroupCount is returned when the number of groups provided does not match the group threshold.
+	ErrInvalidGroupCount = errors.New("wrong number of mnemonic groups")
+	// ErrInvalidMemberCount is returned when the number of mnemonics in a group does not match its member threshold.
+	ErrInvalidMemberCount = errors.New("wrong number of mnemonics in group")
+	// ErrInvalidShares is returned when shares have duplicate indexes or different lengths.
+	ErrInvalidShares = errors.New("invalid set of shares")
+	// ErrInvalidDigest is returned when the recovered secret does not match the share digest.
+	ErrInvalidDigest = errors.New("invalid digest of the shared secret")
+)
+
+// Group describes how the shares of a group are created.
+// MemberThreshold of the MemberCount shares are needed to recover the group.
+type Group struct {
+	MemberThreshold int
+	MemberCount     int
+}
+
+// GenerateMnemonics splits the master secret into groups of mnemonic shares.
+// The master secret is encrypted with the passphrase, 10000 * 2^iterationExponent PBKDF2 iterations are used.
+// Recovery needs groupThreshold groups each with their member threshold of mnemonics.
+// The mnemonics are returned in the same order as the groups.
+func GenerateMnemonics(rand io.Reader, groupThreshold int, groups []Group, masterSecret []byte, passphrase string, iterationExponent int) ([][]string, error) {
+	if len(masterSecret)*8 < minStrengthBits || len(masterSecret)%2 != 0 {
+		return nil, ErrInvalidMasterSecretLength
+	}
+
+	if iterationExponent < 0 || iterationExponent > MaxIterationExponent {
+		return nil, ErrInvalidIterationExponent
+	}
+
+	if groupThreshold < 1 || groupThreshold > len(groups) || len(groups) > maxShareCount {
+		return nil, ErrInvalidGroupThreshold
+	}
+
+	for _, g := range groups {
+		if g.MemberThreshold == 1 && g.MemberCount > 1 {
+			return nil, ErrInvalidThreshold
+		}
+	}
+
+	if err := validatePassphrase(passphrase); err != nil {
+		return nil, err
+	}
+
+	idBytes, err := randomBytes(rand, 2)
+	if err != nil {
+		return nil, err
+	}
+
+	identifier := (int(idBytes[0])<<8 | int(idBytes[1])) & (1<<idLengthBits - 1)
+	encryptedMasterSecret := encrypt(masterSecret, []byte(passphrase), iterationExponent, identifier, true)
+
+	groupShares, err := splitSecret(rand, groupThreshold, len(groups), encryptedMasterSecret)
+	if err != nil {
+		return nil, err
+	}
+
+	mnemonics := make([][]string, len(groups))
+
+	for i, groupShare := range groupShares {
+		memberShares, err := splitSecret(rand, groups[i].MemberThreshold, groups[i].MemberCount, groupShare.data)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, memberShare := range memberShares {
+			s := share{
+				identifier:        identifier,
+				extendable:        true,
+				iterationExponent: iterationExponent,
+				groupIndex:        groupShare.x,
+				groupThreshold:    groupThreshold,
+				groupCount:        len(groups),
+				memberIndex:       memberShare.x,
+				memberThreshold:   groups[i].MemberThreshold,
+				value:             memberShare.data,
+			}
+			mnemonics[i] = append(mnemonics[i], s.mnemonic())
+		}
+	}
+
+	return mnemonics, nil
+}
+
+// CombineMnemonics recovers the master secret from the mnemonics and decrypts it with the passphrase.
+// Exactly the group threshold of groups must be provided, each with exactly its member threshold of mnemonics.
+// Any passphrase decrypts to a master secret, there is no way to detect an incorrect passphrase.
+func CombineMnemonics(mnemonics []string, passphrase string) ([]byte, error) {
+	if err := validatePassphrase(passphrase); err != nil {
+		return nil, err
+	}
+
+	first, groups, err := decodeMnemonics(mnemonics)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(groups) != first.groupThreshold {
+		return nil, ErrInvalidGroupCount
+	}
+
+	groupShares := make([]rawShare, 0, len(groups))
+
+	for groupIndex, members := range groups {
+		if len(members) != members[0].memberThreshold {
+			return nil, ErrInvalidMemberCount
+		}
+
+		memberShares := make([]rawShare, len(members))
+		for i, member := range members {
+			memberShares[i] = rawShare{x: member.memberIndex, data: member.value}
+		}
+
+		groupSecret, err := recoverSecret(members[0].memberThreshold, memberShares)
+		if err != nil {
+			return nil, err
+		}
+
+		groupShares = append(groupShares, rawShare{x: groupIndex, data: groupSecret})
+	}
+
+	encryptedMasterSecret, err := recoverSecret(first.groupThreshold, groupShares)
+	if err != nil {
+		return nil, err
+	}
+
+	return decrypt(encryptedMasterSecret, []byte(passphrase), first.iterationExponent, first.identifier, first.extendable), nil
+}
+
+// decodeMnemonics decodes the mnemonics into groups of shares, duplicate mnemonics are ignored.
+func decodeMnemonics(mnemonics []string) (*share, map[int][]*share, error) {
+	if len(mnemonics) == 0 {
+		return nil, nil, ErrEmptyShares
+	}
+
+	var first *share
+
+	groups := map[int][]*share{}
+	seen := map[string]bool{}
+
+	for _, mnemonic := range mnemonics {
+		s, err := decodeShare(mnemonic)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if first == nil {
+			first = s
+		}
+
+		if s.identifier != first.identifier || s.extendable != first.extendable || s.iterationExponent != first.iterationExponent ||
+			s.groupThreshold != first.groupThreshold || s.groupCount != first.groupCount {
+			return nil, nil, ErrMismatchedShares
+		}
+
+		if members := groups[s.groupIndex]; len(members) > 0 && members[0].memberThreshold != s.memberThreshold {
+			return nil, nil, ErrMismatchedShares
+		}
+
+		if key := s.mnemonic(); !seen[key] {
+			seen[key] = true
+			groups[s.groupIndex] = append(groups[s.groupIndex], s)
+		}
+	}
+
+	return first, groups, nil
+}
+
+func validatePassphrase(passphrase string) error {
+	for i := 0; i < len(passphrase); i++ {
+		if passphrase[i] < 32 || passphrase[i] > 126 {
+			return ErrInvalidPassphrase
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,571 @@
+package slip39
+
+import (
+	"bytes"
+	"crypto/rand"
+	"strings"
+	"testing"
+
+	"github.com/mailchain/go-encoding/encodingtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCombineMnemonics(t *testing.T) {
+	// vectors from https://github.com/trezor/python-shamir-mnemonic/blob/master/vectors.json, all use the passphrase "TREZOR".
+	tests := []struct {
+		name      string
+		mnemonics []string
+		want      []byte
+		wantErr   error
+	}{
+		{
+			"valid-mnemonic-without-sharing-128-bits",
+			[]string{
+				"duckling enlarge academic academic agency result length solution fridge kidney coal piece deal husband erode duke ajar critical decision keyboard",
+			},
+			encodingtest.MustDecodeHex("bb54aac4b89dc868ba37d9cc21b2cece"),
+			nil,
+		},
+		{
+			"invalid-checksum-128-bits",
+			[]string{
+				"duckling enlarge academic academic agency result length solution fridge kidney coal piece deal husband erode duke ajar critical decision kidney",
+			},
+			nil,
+			ErrInvalidChecksum,
+		},
+		{
+			"invalid-padding-128-bits",
+			[]string{
+				"duckling enlarge academic academic email result length solution fridge kidney coal piece deal husband erode duke ajar music cargo fitness",
+			},
+			nil,
+			ErrInvalidPadding,
+		},
+		{
+			"basic-sharing-2-of-3-128-bits",
+			[]string{
+				"shadow pistol academic always adequate wildlife fancy gross oasis cylinder mustang wrist rescue view short owner flip making coding armed",
+				"shadow pistol academic acid actress prayer class unknown daughter sweater depict flip twice unkind craft early superior advocate guest smoking",
+			},
+			encodingtest.MustDecodeHex("b43ceb7e57a0ea8766221624d01b0864"),
+			nil,
+		},
+		{
+			"basic-sharing-2-of-3-128-bits-insufficient",
+			[]string{
+				"shadow pistol academic always adequate wildlife fancy gross oasis cylinder mustang wrist rescue view short owner flip making coding armed",
+			},
+			nil,
+			ErrInvalidMemberCount,
+		},
+		{
+			"basic-sharing-2-of-3-128-bits-duplicate",
+			[]string{
+				"shadow pistol academic always adequate wildlife fancy gross oasis cylinder mustang wrist rescue view short owner flip making coding armed",
+				"shadow pistol academic always adequate wildlife fancy gross oasis cylinder mustang wrist rescue view short owner flip making coding armed",
+			},
+			nil,
+			ErrInvalidMemberCount,
+		},
+		{
+			"different-identifiers-128-bits",
+			[]string{
+				"adequate smoking academic acid debut wine petition glen cluster slow rhyme slow simple epidemic rumor junk tracks treat olympic tolerate",
+				"adequate stay academic agency agency formal party ting frequent learn upstairs remember smear leaf damage anatomy ladle market hush corner",
+			},
+			nil,
+			ErrMismatchedShares,
+		},
+		{
+			"different-iteration-exponents-128-bits",
+			[]string{
+				"peasant leaves academic acid desert exact olympic math alive axle trial tackle drug deny decent smear dominant desert bucket remind",
+				"peasant leader academic agency cultural blessing percent network envelope medal junk primary human pumps jacket fragment payroll ticket evoke voice",
+			},
+			nil,
+			ErrMismatchedShares,
+		},
+		{
+			"mismatching-group-thresholds-128-bits",
+			[]string{
+				"liberty category beard echo animal fawn temple briefing math username various wolf aviation fancy visual holy thunder yelp helpful payment",
+				"liberty category beard email beyond should fancy romp founder easel pink holy hairy romp loyalty material victim owner toxic custody",
+				"liberty category academic easy being hazard crush diminish oral lizard reaction cluster force dilemma deploy force club veteran expect photo",
+			},
+			nil,
+			ErrMismatchedShares,
+		},
+		{
+			"mismatching-group-counts-128-bits",
+			[]string{
+				"average senior academic leaf broken teacher expect surface hour capture obesity desire negative dynamic dominant pistol mineral mailman iris aide",
+				"average senior academic agency curious pants blimp spew clothes slice script dress wrap firm shaft regular slavery negative theater roster",
+			},
+			nil,
+			ErrMismatchedShares,
+		},
+		{
+			"group-threshold-above-group-count-128-bits",
+			[]string{
+				"music husband acrobat acid artist finance center either graduate swimming object bike medical clothes station aspect spider maiden bulb welcome",
+				"music husband acrobat agency advance hunting bike corner density careful material civil evil tactics remind hawk discuss hobo voice rainbow",
+				"music husband beard academic black tricycle clock mayor estimate level photo episode exclude ecology papa source amazing salt verify divorce",
+			},
+			nil,
+			ErrInvalidGroupThreshold,
+		},
+		{
+			"duplicate-member-indices-128-bits",
+			[]string{
+				"device stay academic always dive coal antenna adult black exceed stadium herald advance soldier busy dryer daughter evaluate minister laser",
+				"device stay academic always dwarf afraid robin gravity crunch adjust soul branch walnut coastal dream costume scholar mortgage mountain pumps",
+			},
+			nil,
+			ErrInvalidShares,
+		},
+		{
+			"mismatching-member-thresholds-128-bits",
+			[]string{
+				"hour painting academic academic device formal evoke guitar random modern justice filter withdraw trouble identify mailman insect general cover oven",
+				"hour painting academic agency artist again daisy capital beaver fiber much enjoy suitable symbolic identify photo editor romp float echo",
+			},
+			nil,
+			ErrMismatchedShares,
+		},
+		{
+			"invalid-digest-128-bits",
+			[]string{
+				"guilt walnut academic acid deliver remove equip listen vampire tactics nylon rhythm failure husband fatigue alive blind enemy teaspoon rebound",
+				"guilt walnut academic agency brave hamster hobo declare herd taste alpha slim criminal mild arcade formal romp branch pink ambition",
+			},
+			nil,
+			ErrInvalidDigest,
+		},
+		{
+			"insufficient-groups-128-bits-case-1",
+			[]string{
+				"eraser senior beard romp adorn nuclear spill corner cradle style ancient family general leader ambition exchange unusual garlic promise voice",
+			},
+			nil,
+			ErrInvalidGroupCount,
+		},
+		{
+			"insufficient-groups-128-bits-case-2",
+			[]string{
+				"eraser senior ceramic snake clay various huge numb argue hesitate auction category timber browser greatest hanger petition script leaf pickup",
+				"eraser senior ceramic shaft dynamic become junior wrist silver peasant force math alto coal amazing segment yelp velvet image paces",
+			},
+			nil,
+			ErrInvalidGroupCount,
+		},
+		{
+			"insufficient-members-in-group-128-bits",
+			[]string{
+				"eraser senior decision roster beard treat identify grumpy salt index fake aviation theater cubic bike cause research dragon emphasis counter",
+				"eraser senior ceramic shaft dynamic become junior wrist silver peasant force math alto coal amazing segment yelp velvet image paces",
+			},
+			nil,
+			ErrInvalidMemberCount,
+		},
+		{
+			"group-sharing-128-bits-case-1",
+			[]string{
+				"eraser senior ceramic round column hawk trust auction smug shame alive greatest sheriff living perfect corner chest sled fumes adequate",
+				"eraser senior decision smug corner ruin rescue cubic angel tackle skin skunk program roster trash rumor slush angel flea amazing",
+				"eraser senior ceramic shaft dynamic become junior wrist silver peasant force math alto coal amazing segment yelp velvet image paces",
+				"eraser senior decision scared cargo theory device idea deliver modify curly include pancake both news skin realize vitamins away join",
+				"eraser senior ceramic snake clay various huge numb argue hesitate auction category timber browser greatest hanger petition script leaf pickup",
+			},
+			encodingtest.MustDecodeHex("7c3397a292a5941682d7a4ae2d898d11"),
+			nil,
+		},
+		{
+			"group-sharing-128-bits-case-2",
+			[]string{
+				"eraser senior decision scared cargo theory device idea deliver modify curly include pancake both news skin realize vitamins away join",
+				"eraser senior decision roster beard treat identify grumpy salt index fake aviation theater cubic bike cause research dragon emphasis counter",
+				"eraser senior beard romp adorn nuclear spill corner cradle style ancient family general leader ambition exchange unusual garlic promise voice",
+			},
+			encodingtest.MustDecodeHex("7c3397a292a5941682d7a4ae2d898d11"),
+			nil,
+		},
+		{
+			"group-sharing-128-bits-case-3",
+			[]string{
+				"eraser senior beard romp adorn nuclear spill corner cradle style ancient family general leader ambition exchange unusual garlic promise voice",
+				"eraser senior acrobat romp bishop medical gesture pumps secret alive ultimate quarter priest subject class dictate spew material endless market",
+			},
+			encodingtest.MustDecodeHex("7c3397a292a5941682d7a4ae2d898d11"),
+			nil,
+		},
+		{
+			"valid-mnemonic-without-sharing-256-bits",
+			[]string{
+				"theory painting academic academic armed sweater year military elder discuss acne wildlife boring employer fused large satoshi bundle carbon diagnose anatomy hamster leaves tracks paces beyond phantom capital marvel lips brave detect luck",
+			},
+			encodingtest.MustDecodeHex("989baf9dcaad5b10ca33dfd8cc75e42477025dce88ae83e75a230086a0e00e92"),
+			nil,
+		},
+		{
+			"invalid-checksum-256-bits",
+			[]string{
+				"theory painting academic academic armed sweater year military elder discuss acne wildlife boring employer fused large satoshi bundle carbon diagnose anatomy hamster leaves tracks paces beyond phantom capital marvel lips brave detect lunar",
+			},
+			nil,
+			ErrInvalidChecksum,
+		},
+		{
+			"basic-sharing-2-of-3-256-bits",
+			[]string{
+				"humidity disease academic always aluminum jewelry energy woman receiver strategy amuse duckling lying evidence network walnut tactics forget hairy rebound impulse brother survive clothes stadium mailman rival ocean reward venture always armed unwrap",
+				"humidity disease academic agency actress jacket gross physics cylinder solution fake mortgage benefit public busy prepare sharp friar change work slow purchase ruler again tricycle involve viral wireless mixture anatomy desert cargo upgrade",
+			},
+			encodingtest.MustDecodeHex("c938b319067687e990e05e0da0ecce1278f75ff58d9853f19dcaeed5de104aae"),
+			nil,
+		},
+		{
+			"basic-sharing-2-of-3-256-bits-insufficient",
+			[]string{
+				"humidity disease academic always aluminum jewelry energy woman receiver strategy amuse duckling lying evidence network walnut tactics forget hairy rebound impulse brother survive clothes stadium mailman rival ocean reward venture always armed unwrap",
+			},
+			nil,
+			ErrInvalidMemberCount,
+		},
+		{
+			"group-sharing-256-bits-case-1",
+			[]string{
+				"wildlife deal ceramic round aluminum pitch goat racism employer miracle percent math decision episode dramatic editor lily prospect program scene rebuild display sympathy have single mustang junction relate often chemical society wits estate",
+				"wildlife deal decision scared acne fatal snake paces obtain election dryer dominant romp tactics railroad marvel trust helpful flip peanut theory theater photo luck install entrance taxi step oven network dictate intimate listen",
+				"wildlife deal ceramic scatter argue equip vampire together ruin reject literary rival distance aquatic agency teammate rebound false argue miracle stay again blessing peaceful unknown cover beard acid island language debris industry idle",
+				"wildlife deal ceramic snake agree voter main lecture axis kitchen physics arcade velvet spine idea scroll promise platform firm sharp patrol divorce ancestor fantasy forbid goat ajar believe swimming cowboy symbolic plastic spelling",
+				"wildlife deal decision shadow analysis adjust bulb skunk muscle mandate obesity total guitar coal gravity carve slim jacket ruin rebuild ancestor numerous hour mortgage require herd maiden public ceiling pecan pickup shadow club",
+			},
+			encodingtest.MustDecodeHex("5385577c8cfc6c1a8aa0f7f10ecde0a3318493262591e78b8c14c6686167123b"),
+			nil,
+		},
+		{
+			"group-sharing-256-bits-case-2",
+			[]string{
+				"wildlife deal beard romp alcohol space mild usual clothes union nuclear testify course research heat listen task location thank hospital slice smell failure fawn helpful priest ambition average recover lecture process dough stadium",
+				"wildlife deal acrobat romp anxiety axis starting require metric flexible geology game drove editor edge screw helpful have huge holy making pitch unknown carve holiday numb glasses survive already tenant adapt goat fangs",
+			},
+			encodingtest.MustDecodeHex("5385577c8cfc6c1a8aa0f7f10ecde0a3318493262591e78b8c14c6686167123b"),
+			nil,
+		},
+		{
+			"insufficient-mnemonic-length",
+			[]string{
+				"junk necklace academic academic acne isolate join hesitate lunar roster dough calcium chemical ladybug amount mobile glasses verify cylinder",
+			},
+			nil,
+			ErrInvalidMnemonicLength,
+		},
+		{
+			"invalid-master-secret-length",
+			[]string{
+				"fraction necklace academic academic award teammate mouse regular testify coding building member verdict purchase blind camera duration email prepare spirit quarter",
+			},
+			nil,
+			ErrInvalidPadding,
+		},
+		{
+			"valid-extendable-mnemonic-without-sharing-128-bits",
+			[]string{
+				"testify swimming academic academic column loyalty smear include exotic bedroom exotic wrist lobe cover grief golden smart junior estimate learn",
+			},
+			encodingtest.MustDecodeHex("1679b4516e0ee5954351d288a838f45e"),
+			nil,
+		},
+		{
+			"extendable-basic-sharing-2-of-3-128-bits",
+			[]string{
+				"enemy favorite academic acid cowboy phrase havoc level response walnut budget painting inside trash adjust froth kitchen learn tidy punish",
+				"enemy favorite academic always academic sniff script carpet romp kind promise scatter center unfair training emphasis evening belong fake enforce",
+			},
+			encodingtest.MustDecodeHex("48b1a4b80b8c209ad42c33672bdaa428"),
+			nil,
+		},
+		{
+			"valid-extendable-mnemonic-without-sharing-256-bits",
+			[]string{
+				"impulse calcium academic academic alcohol sugar lyrics pajamas column facility finance tension extend space birthday rainbow swimming purple syndrome facility trial warn duration snapshot shadow hormone rhyme public spine counter easy hawk album",
+			},
+			encodingtest.MustDecodeHex("8340611602fe91af634a5f4608377b5235fa2d757c51d720c0c7656249a3035f"),
+			nil,
+		},
+		{
+			"extendable-basic-sharing-2-of-3-256-bits",
+			[]string{
+				"western apart academic always artist resident briefing sugar woman oven coding club ajar merit pecan answer prisoner artist fraction amount desktop mild false necklace muscle photo wealthy alpha category unwrap spew losing making",
+				"western apart academic acid answer ancient auction flip image penalty oasis beaver multiple thunder problem switch alive heat inherit superior teaspoon explain blanket pencil numb lend punish endless aunt garlic humidity kidney observe",
+			},
+			encodingtest.MustDecodeHex("8dc652d6d6cd370d8c963141f6d79ba440300f25c467302c1d966bff8f62300d"),
+			nil,
+		},
+		// cases not in vectors.json
+		{
+			"unknown-word",
+			[]string{
+				"duckling enlarge academic academic agency result length solution fridge kidney coal piece deal husband erode duke ajar critical decision keyboards",
+			},
+			nil,
+			ErrUnknownWord,
+		},
+		{
+			"too-short",
+			[]string{
+				"duckling enlarge academic academic agency result length solution fridge kidney coal piece deal husband erode duke ajar critical keyboard",
+			},
+			nil,
+			ErrInvalidMnemonicLength,
+		},
+		{
+			"empty",
+			[]string{},
+			nil,
+			ErrEmptyShares,
+		},
+		{
+			"mismatched-identifiers",
+			[]string{
+				"duckling enlarge academic academic agency result length solution fridge kidney coal piece deal husband erode duke ajar critical decision keyboard",
+				"shadow pistol academic always adequate wildlife fancy gross oasis cylinder mustang wrist rescue view short owner flip making coding armed",
+			},
+			nil,
+			ErrMismatchedShares,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := CombineMnemonics(tt.mnemonics, "TREZOR")
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCombineMnemonicsPassphrase(t *testing.T) {
+	mnemonics := []string{
+		"duckling enlarge academic academic agency result length solution fridge kidney coal piece deal husband erode duke ajar critical decision keyboard",
+	}
+
+	withoutPassphrase, err := CombineMnemonics(mnemonics, "")
+	require.NoError(t, err)
+	assert.NotEqual(t, encodingtest.MustDecodeHex("bb54aac4b89dc868ba37d9cc21b2cece"), withoutPassphrase)
+
+	_, err = CombineMnemonics(mnemonics, "TREZORé")
+	assert.Equal(t, ErrInvalidPassphrase, err)
+}
+
+func TestGenerateMnemonics(t *testing.T) {
+	masterSecret := encodingtest.MustDecodeHex("bb54aac4b89dc868ba37d9cc21b2cece5d2e1c4b1d8a7b9dbcc6f0a1c2e3f401")
+	groups := []Group{
+		{MemberThreshold: 1, MemberCount: 1},
+		{MemberThreshold: 1, MemberCount: 1},
+		{MemberThreshold: 2, MemberCount: 5},
+		{MemberThreshold: 3, MemberCount: 6},
+	}
+
+	mnemonics, err := GenerateMnemonics(rand.Reader, 2, groups, masterSecret, "TREZOR", 0)
+	require.NoError(t, err)
+	require.Len(t, mnemonics, len(groups))
+
+	for i, g := range groups {
+		assert.Len(t, mnemonics[i], g.MemberCount)
+		for _, m := range mnemonics[i] {
+			assert.Len(t, strings.Fields(m), 33)
+		}
+	}
+
+	tests := []struct {
+		name      string
+		mnemonics []string
+		wantErr   error
+	}{
+		{
+			"groups-0-1",
+			[]string{mnemonics[0][0], mnemonics[1][0]},
+			nil,
+		},
+		{
+			"groups-2-3",
+			[]string{mnemonics[2][4], mnemonics[2][1], mnemonics[3][0], mnemonics[3][5], mnemonics[3][2]},
+			nil,
+		},
+		{
+			"groups-0-2",
+			[]string{mnemonics[2][3], mnemonics[0][0], mnemonics[2][0]},
+			nil,
+		},
+		{
+			"insufficient-groups",
+			[]string{mnemonics[2][0], mnemonics[2][1]},
+			ErrInvalidGroupCount,
+		},
+		{
+			"too-many-groups",
+			[]string{mnemonics[0][0], mnemonics[1][0], mnemonics[2][0], mnemonics[2][1]},
+			ErrInvalidGroupCount,
+		},
+		{
+			"insufficient-members",
+			[]string{mnemonics[0][0], mnemonics[3][0], mnemonics[3][1]},
+			ErrInvalidMemberCount,
+		},
+		{
+			"too-many-members",
+			[]string{mnemonics[0][0], mnemonics[2][0], mnemonics[2][1], mnemonics[2][2]},
+			ErrInvalidMemberCount,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := CombineMnemonics(tt.mnemonics, "TREZOR")
+			assert.Equal(t, tt.wantErr, err)
+			if tt.wantErr == nil {
+				assert.Equal(t, masterSecret, got)
+			}
+		})
+	}
+}
+
+func TestGenerateMnemonicsSingleShare(t *testing.T) {
+	masterSecret := bytes.Repeat([]byte{0x5a}, 16)
+
+	mnemonics, err := GenerateMnemonics(rand.Reader, 1, []Group{{MemberThreshold: 1, MemberCount: 1}}, masterSecret, "", 1)
+	require.NoError(t, err)
+	require.Len(t, mnemonics, 1)
+	require.Len(t, mnemonics[0], 1)
+	assert.Len(t, strings.Fields(mnemonics[0][0]), 20)
+
+	got, err := CombineMnemonics(mnemonics[0], "")
+	assert.NoError(t, err)
+	assert.Equal(t, masterSecret, got)
+}
+
+func TestGenerateMnemonicsInvalid(t *testing.T) {
+	masterSecret := bytes.Repeat([]byte{0x5a}, 16)
+	tests := []struct {
+		name              string
+		groupThreshold    int
+		groups            []Group
+		masterSecret      []byte
+		passphrase        string
+		iterationExponent int
+		wantErr           error
+	}{
+		{
+			"short-master-secret",
+			1,
+			[]Group{{1, 1}},
+			masterSecret[:14],
+			"",
+			0,
+			ErrInvalidMasterSecretLength,
+		},
+		{
+			"odd-master-secret",
+			1,
+			[]Group{{1, 1}},
+			append(masterSecret, 0x01),
+			"",
+			0,
+			ErrInvalidMasterSecretLength,
+		},
+		{
+			"group-threshold-greater-than-count",
+			3,
+			[]Group{{1, 1}, {1, 1}},
+			masterSecret,
+			"",
+			0,
+			ErrInvalidGroupThreshold,
+		},
+		{
+			"group-threshold-zero",
+			0,
+			[]Group{{1, 1}},
+			masterSecret,
+			"",
+			0,
+			ErrInvalidGroupThreshold,
+		},
+		{
+			"member-threshold-1-of-many",
+			1,
+			[]Group{{1, 3}},
+			masterSecret,
+			"",
+			0,
+			ErrInvalidThreshold,
+		},
+		{
+			"member-threshold-greater-than-count",
+			1,
+			[]Group{{4, 3}},
+			masterSecret,
+			"",
+			0,
+			ErrInvalidThreshold,
+		},
+		{
+			"member-count-too-large",
+			1,
+			[]Group{{2, 17}},
+			masterSecret,
+			"",
+			0,
+			ErrInvalidThreshold,
+		},
+		{
+			"iteration-exponent",
+			1,
+			[]Group{{1, 1}},
+			masterSecret,
+			"",
+			16,
+			ErrInvalidIterationExponent,
+		},
+		{
+			"passphrase",
+			1,
+			[]Group{{1, 1}},
+			masterSecret,
+			"\n",
+			0,
+			ErrInvalidPassphrase,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := GenerateMnemonics(rand.Reader, tt.groupThreshold, tt.groups, tt.masterSecret, tt.passphrase, tt.iterationExponent)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Nil(t, got)
+		})
+	}
+}
+
+func TestShareRoundTrip(t *testing.T) {
+	s := share{
+		identifier:        0x7fff,
+		extendable:        false,
+		iterationExponent: 15,
+		groupIndex:        15,
+		groupThreshold:    16,
+		groupCount:        16,
+		memberIndex:       15,
+		memberThreshold:   16,
+		value:             bytes.Repeat([]byte{0xff}, 32),
+	}
+
+	got, err := decodeShare(s.mnemonic())
+	require.NoError(t, err)
+	assert.Equal(t, &s, got)
+
+	// uppercase and extra whitespace are accepted.
+	got, err = decodeShare("  " + strings.ToUpper(s.mnemonic()) + "\n")
+	require.NoError(t, err)
+	assert.Equal(t, &s, got)
+}
@@ -0,0 +1,56 @@
+package slip39
+
+import (
+	_ "embed"
+	"strings"
+)
+
+const (
+	radixBits = 10
+	radix     = 1 << radixBits
+)
+
+//go:embed wordlist.txt
+var wordlistFile string
+
+// wordlist is the SLIP-39 wordlist of 1024 words, see https://github.com/satoshilabs/slips/blob/master/slip-0039/wordlist.txt.
+var wordlist, wordIndexes = mustLoadWordlist() //nolint: gochecknoglobals
+
+func mustLoadWordlist() ([]string, map[string]int) {
+	words := strings.Split(strings.TrimSpace(wordlistFile), "\n")
+	if len(words) != radix {
+		panic("invalid slip39 wordlist length")
+	}
+
+	indexes := make(map[string]int, len(words))
+	for i, word := range words {
+		indexes[word] = i
+	}
+
+	return words, indexes
+}
+
+func wordsToIndexes(mnemonic string) ([]int, error) {
+	words := strings.Fields(strings.ToLower(mnemonic))
+	indexes := make([]int, len(words))
+
+	for i, word := range words {
+		index, ok := wordIndexes[word]
+		if !ok {
+			return nil, ErrUnknownWord
+		}
+
+		indexes[i] = index
+	}
+
+	return indexes, nil
+}
+
+func indexesToWords(indexes []int) string {
+	words := make([]string, len(indexes))
+	for i, index := range indexes {
+		words[i] = wordlist[index]
+	}
+
+	return strings.Join(words, " ")
+}
@@ -0,0 +1,98 @@
+package sr25519
+
+import (
+	"crypto/rand"
+	"io"
+
+	"github.com/gtank/ristretto255"
+)
+
+// BatchEntry is a signature to verify as part of a batch.
+type BatchEntry struct {
+	PublicKey PublicKey
+	Message   []byte
+	Signature []byte
+}
+
+// VerifyBatch verifies all the signatures in entries with the `substrate` signing context at once using a
+// randomized multi-scalar multiplication, which for large batches is much faster than calling Verify for every entry.
+// It returns true when all the signatures are valid, otherwise the entries are verified one at a time and
+// valid reports which of the entries have a valid signature.
+func VerifyBatch(entries []BatchEntry) (ok bool, valid []bool) {
+	return verifyBatch(rand.Reader, substrateContext, entries)
+}
+
+// VerifyBatchWithContext verifies all the signatures in entries with the signing context, see VerifyBatch.
+func VerifyBatchWithContext(context []byte, entries []BatchEntry) (ok bool, valid []bool) {
+	return verifyBatch(rand.Reader, context, entries)
+}
+
+func verifyBatch(rand io.Reader, context []byte, entries []BatchEntry) (ok bool, valid []bool) {
+	if batchEquation(rand, context, entries) {
+		valid = make([]bool, len(entries))
+		for i := range valid {
+			valid[i] = true
+		}
+
+		return true, valid
+	}
+
+	ok = true
+	valid = make([]bool, len(entries))
+
+	for i, e := range entries {
+		valid[i] = e.PublicKey.VerifyWithContext(context, e.Message, e.Signature)
+		ok = ok && valid[i]
+	}
+
+	return ok, valid
+}
+
+// batchEquation checks (-∑ zᵢsᵢ)B + ∑ zᵢRᵢ + ∑ (zᵢkᵢ)Aᵢ = 0 for random 128 bit zᵢ.
+// https://github.com/w3f/schnorrkel/blob/4112f6e8cb684a1cc6574f9097497e1e302ab9a8/src/batch.rs#L69
+func batchEquation(rand io.Reader, context []byte, entries []BatchEntry) bool {
+	scalars := make([]*ristretto255.Scalar, 1, 1+2*len(entries))
+	points := make([]*ristretto255.Element, 1, 1+2*len(entries))
+	scalars[0] = ristretto255.NewScalar()
+	points[0] = ristretto255.NewElement().Base()
+
+	zkScalars := make([]*ristretto255.Scalar, 0, len(entries))
+	aPoints := make([]*ristretto255.Element, 0, len(entries))
+
+	for _, e := range entries {
+		sig := signature{}
+		if err := sig.Decode(e.Signature); err != nil {
+			return false
+		}
+
+		a := ristretto255.NewElement()
+		if err := a.Decode(e.PublicKey.key); err != nil {
+			return false
+		}
+
+		k := e.PublicKey.challenge(NewSigningTranscript(context, e.Message), sig.R)
+
+		zBytes := make([]byte, 32)
+		if _, err := io.ReadFull(rand, zBytes[:16]); err != nil {
+			return false
+		}
+
+		z := ristretto255.NewScalar()
+		if err := z.Decode(zBytes); err != nil {
+			return false
+		}
+
+		scalars[0].Subtract(scalars[0], ristretto255.NewScalar().Multiply(z, sig.S))
+		scalars = append(scalars, z)
+		points = append(points, sig.R)
+		zkScalars = append(zkScalars, k.Multiply(z, k))
+		aPoints = append(aPoints, a)
+	}
+
+	scalars = append(scalars, zkScalars...)
+	points = append(points, aPoints...)
+
+	check := ristretto255.NewElement().VarTimeMultiScalarMult(scalars, points)
+
+	return check.Equal(ristretto255.NewElement().Zero()) == 1
+}
@@ -0,0 +1,110 @@
+package sr25519
+
+import (
+	"bytes"
+	"crypto/rand"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newBatchEntries(t *testing.T, context []byte, n int) []BatchEntry {
+	entries := make([]BatchEntry, n)
+
+	for i := range entries {
+		key, err := GenerateKey(rand.Reader)
+		if !assert.NoError(t, err) {
+			t.FailNow()
+		}
+
+		message := []byte(fmt.Sprintf("message %d", i))
+		sig, err := key.SignWithContext(context, message)
+		if !assert.NoError(t, err) {
+			t.FailNow()
+		}
+
+		entries[i] = BatchEntry{PublicKey: *key.PublicKey().(*PublicKey), Message: message, Signature: sig}
+	}
+
+	return entries
+}
+
+func TestVerifyBatch(t *testing.T) {
+	entries := newBatchEntries(t, substrateContext, 32)
+
+	ok, valid := VerifyBatch(entries)
+	assert.True(t, ok)
+	assert.Len(t, valid, 32)
+	assert.NotContains(t, valid, false)
+
+	ok, valid = VerifyBatch(nil)
+	assert.True(t, ok)
+	assert.Empty(t, valid)
+}
+
+func TestVerifyBatchWithContext(t *testing.T) {
+	entries := newBatchEntries(t, []byte("mailchain"), 8)
+
+	ok, valid := VerifyBatchWithContext([]byte("mailchain"), entries)
+	assert.True(t, ok)
+	assert.NotContains(t, valid, false)
+
+	ok, valid = VerifyBatch(newBatchEntries(t, []byte("mailchain"), 8))
+	assert.False(t, ok)
+	assert.NotContains(t, valid, true)
+}
+
+func TestVerifyBatchInvalid(t *testing.T) {
+	tests := []struct {
+		name      string
+		modify    func(entries []BatchEntry)
+		wantValid []bool
+	}{
+		{
+			"wrong-message",
+			func(entries []BatchEntry) { entries[3].Message = []byte("other") },
+			[]bool{true, true, true, false, true, true, true, true},
+		},
+		{
+			"wrong-key",
+			func(entries []BatchEntry) { entries[3].PublicKey = entries[4].PublicKey },
+			[]bool{true, true, true, false, true, true, true, true},
+		},
+		{
+			"swapped-signatures",
+			func(entries []BatchEntry) {
+				entries[3].Signature, entries[7].Signature = entries[7].Signature, entries[3].Signature
+			},
+			[]bool{true, true, true, false, true, true, true, false},
+		},
+		{
+			"short-signature",
+			func(entries []BatchEntry) { entries[3].Signature = entries[3].Signature[:63] },
+			[]bool{true, true, true, false, true, true, true, true},
+		},
+		{
+			"missing-marker",
+			func(entries []BatchEntry) { entries[3].Signature[63] &= 127 },
+			[]bool{true, true, true, false, true, true, true, true},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entries := newBatchEntries(t, substrateContext, 8)
+			tt.modify(entries)
+
+			ok, valid := VerifyBatch(entries)
+			assert.False(t, ok)
+			assert.Equal(t, tt.wantValid, valid)
+		})
+	}
+}
+
+func TestVerifyBatchRandError(t *testing.T) {
+	entries := newBatchEntries(t, substrateContext, 4)
+
+	ok, valid := verifyBatch(bytes.NewReader(nil), substrateContext, entries)
+	assert.True(t, ok)
+	assert.Equal(t, []bool{true, true, true, true}, valid)
+}
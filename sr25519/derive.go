@@ -0,0 +1,84 @@
+package sr25519
+
+import (
+	"crypto/rand"
+	"errors"
+
+	"github.com/mailchain/go-crypto"
+	"github.com/mailchain/go-crypto/internal/schnorrkel"
+)
+
+// ErrDeriveHardenedFromPublic is returned when hard derivation is requested from a public key.
+var ErrDeriveHardenedFromPublic = errors.New("cannot hard derive a key from a public key")
+
+// DeriveHardenedKey derives a child private key using schnorrkel hard derivation, `//junction` in substrate.
+// The chaincode is the junction as created by the chaincode package.
+func DeriveHardenedKey(parent crypto.PrivateKey, chaincode []byte) (*PrivateKey, error) {
+	parentKey, err := privateKey(parent)
+	if err != nil {
+		return nil, err
+	}
+
+	// substrate hard derives with an empty message, see derive_hard_junction in sp-core sr25519.
+	miniSecretKey, _, err := parentKey.secretKey.HardDeriveMiniSecretKey(chaincode, []byte{})
+	if err != nil {
+		return nil, err
+	}
+
+	return PrivateKeyFromBytes(miniSecretKey[:])
+}
+
+// DeriveSoftKey derives a child private key using schnorrkel soft derivation, `/junction` in substrate.
+// The chaincode is the junction as created by the chaincode package.
+// The public key of the child matches the key returned by DeriveSoftPublicKey for the parent public key.
+func DeriveSoftKey(parent crypto.PrivateKey, chaincode []byte) (*PrivateKey, error) {
+	parentKey, err := privateKey(parent)
+	if err != nil {
+		return nil, err
+	}
+
+	childSecretKey, _, err := parentKey.secretKey.DerivedKeySimple(parentKey.PublicKey().Bytes(), chaincode, []byte{}, rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PrivateKey{secretKey: childSecretKey}, nil
+}
+
+// DeriveSoftPublicKey derives a child public key using schnorrkel soft derivation, `/junction` in substrate.
+// Only the public key is required, the private key of the child can be derived with DeriveSoftKey.
+func DeriveSoftPublicKey(parent crypto.PublicKey, chaincode []byte) (*PublicKey, error) {
+	parentKey, err := publicKey(parent)
+	if err != nil {
+		return nil, err
+	}
+
+	childKey, _, err := schnorrkel.DerivedPublicKeySimple(parentKey.key, chaincode, []byte{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &PublicKey{key: childKey}, nil
+}
+
+func privateKey(key crypto.PrivateKey) (*PrivateKey, error) {
+	switch srKey := key.(type) {
+	case *PrivateKey:
+		return srKey, nil
+	case PrivateKey:
+		return &srKey, nil
+	default:
+		return nil, errors.New("unknown private key type")
+	}
+}
+
+func publicKey(key crypto.PublicKey) (*PublicKey, error) {
+	switch srKey := key.(type) {
+	case *PublicKey:
+		return srKey, nil
+	case PublicKey:
+		return &srKey, nil
+	default:
+		return nil, errors.New("unknown public key type")
+	}
+}
@@ -0,0 +1,221 @@
+package sr25519
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/mailchain/go-crypto"
+	"github.com/mailchain/go-crypto/chaincode"
+	"github.com/mailchain/go-encoding/encodingtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// devSeed is the mini secret key of the substrate development phrase
+// `bottom drive obey lake curtain smoke basket hold race lonely fit walk`.
+var devSeed = encodingtest.MustDecodeHex("fac7959dbfe72f052e5a0c3c8d6530f202b02fd8f9f5ca3580ec8deb7797479e") //nolint: gochecknoglobals
+
+// subkeySeed is the mini secret key of the phrase
+// `crowd swamp sniff machine grid pretty client emotion banana cricket flush soap`.
+var subkeySeed = encodingtest.MustDecodeHex("18446f2d685492c3086391aabe8f5e235c3c2e02521985650f0c97052237e717") //nolint: gochecknoglobals
+
+type junction struct {
+	hard bool
+	name string
+}
+
+func (j junction) chainCode() []byte {
+	if number, err := strconv.ParseUint(j.name, 10, 64); err == nil {
+		return chaincode.ChainCodeFromDeriveIndexUint64(number)
+	}
+
+	return chaincode.ChainCodeFromDeriveIndexString(j.name)
+}
+
+func deriveJunctions(t *testing.T, parent *PrivateKey, junctions []junction) *PrivateKey {
+	var err error
+	for _, j := range junctions {
+		if j.hard {
+			parent, err = DeriveHardenedKey(parent, j.chainCode())
+		} else {
+			parent, err = DeriveSoftKey(parent, j.chainCode())
+		}
+		require.NoError(t, err)
+	}
+
+	return parent
+}
+
+func TestDeriveKnownKeys(t *testing.T) {
+	tests := []struct {
+		name          string
+		seed          []byte
+		junctions     []junction
+		wantPublicKey []byte
+	}{
+		{
+			"//Alice",
+			devSeed,
+			[]junction{{true, "Alice"}},
+			encodingtest.MustDecodeHex("d43593c715fdd31c61141abd04a99fd6822c8558854ccde39a5684e7a56da27d"),
+		},
+		{
+			"//Alice//stash",
+			devSeed,
+			[]junction{{true, "Alice"}, {true, "stash"}},
+			encodingtest.MustDecodeHex("be5ddb1579b72e84524fc29e78609e3caf42e85aa118ebfe0b0ad404b5bdd25f"),
+		},
+		{
+			"//Bob",
+			devSeed,
+			[]junction{{true, "Bob"}},
+			encodingtest.MustDecodeHex("8eaf04151687736326c9fea17e25fc5287613693c912909cb226aa4794f26a48"),
+		},
+		// subkey vectors of the phrase `crowd swamp sniff machine grid pretty client emotion banana cricket flush soap`,
+		// from https://github.com/vedhavyas/go-subkey/blob/v1.0.3/scheme_test.go
+		{
+			"crowd-swamp",
+			subkeySeed,
+			[]junction{},
+			encodingtest.MustDecodeHex("88af895626c47cf1235ec3898d238baeb41adca3117b9a77bc2f6b78eca0771b"),
+		},
+		{
+			"crowd-swamp/foo",
+			subkeySeed,
+			[]junction{{false, "foo"}},
+			encodingtest.MustDecodeHex("287061f5973551d070ccc62fb4563a0be2e6324ce183c456850e342aa021f94d"),
+		},
+		{
+			"crowd-swamp//foo//42",
+			subkeySeed,
+			[]junction{{true, "foo"}, {true, "42"}},
+			encodingtest.MustDecodeHex("de4255b281cda3580a7aad6d2c7efd990e6b31569ab1a0a8adc18b32e4fa510f"),
+		},
+		{
+			"crowd-swamp//foo/bar",
+			subkeySeed,
+			[]junction{{true, "foo"}, {false, "bar"}},
+			encodingtest.MustDecodeHex("0c6febc87c461f8ddceb295d90c3ba999b1e93c2bdd13145b265512d06729449"),
+		},
+		{
+			"crowd-swamp/foo//bar",
+			subkeySeed,
+			[]junction{{false, "foo"}, {true, "bar"}},
+			encodingtest.MustDecodeHex("e4535b3b8e259badc3c78128bfafe0b50df625862edaff7c9d68999a0811865b"),
+		},
+		{
+			"crowd-swamp//foo/bar//42/69",
+			subkeySeed,
+			[]junction{{true, "foo"}, {false, "bar"}, {true, "42"}, {false, "69"}},
+			encodingtest.MustDecodeHex("68a5a8f7e29ffcae1d15518b180f6e4f1132b45ffd565cb7953045faf07c8809"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root, err := PrivateKeyFromBytes(tt.seed)
+			require.NoError(t, err)
+
+			got := deriveJunctions(t, root, tt.junctions)
+			assert.Equal(t, tt.wantPublicKey, got.PublicKey().Bytes())
+		})
+	}
+}
+
+func TestDeriveHardenedKey(t *testing.T) {
+	root, err := PrivateKeyFromBytes(devSeed)
+	require.NoError(t, err)
+
+	got, err := DeriveHardenedKey(root, chaincode.ChainCodeFromDeriveIndexString("Alice"))
+	require.NoError(t, err)
+	assert.Equal(t, encodingtest.MustDecodeHex("e5be9a5092b81bca64be81d212e7f2f9eba183bb7a90954f7b76361f6edb5c0a"), got.Bytes())
+
+	_, err = DeriveHardenedKey(root, []byte{0x1})
+	assert.Error(t, err)
+
+	_, err = DeriveHardenedKey(nil, chaincode.ChainCodeFromDeriveIndexString("Alice"))
+	assert.Error(t, err)
+}
+
+func TestDeriveSoftKey(t *testing.T) {
+	tests := []struct {
+		name   string
+		parent crypto.PrivateKey
+		path   []uint64
+	}{
+		{
+			"alice/0",
+			&alicePrivateKey,
+			[]uint64{0},
+		},
+		{
+			"alice/1/2",
+			&alicePrivateKey,
+			[]uint64{1, 2},
+		},
+		{
+			"bob/1/2/3",
+			bobPrivateKey,
+			[]uint64{1, 2, 3},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			parentPrivate := tt.parent
+			parentPublic := tt.parent.PublicKey()
+			for _, item := range tt.path {
+				privateChild, err := DeriveSoftKey(parentPrivate, chaincode.ChainCodeFromDeriveIndexUint64(item))
+				require.NoError(t, err)
+				publicChild, err := DeriveSoftPublicKey(parentPublic, chaincode.ChainCodeFromDeriveIndexUint64(item))
+				require.NoError(t, err)
+
+				assert.Equal(t, publicChild.Bytes(), privateChild.PublicKey().Bytes())
+				parentPrivate, parentPublic = privateChild, publicChild
+			}
+
+			message := []byte("message")
+			sig, err := parentPrivate.Sign(message)
+			require.NoError(t, err)
+			assert.True(t, parentPublic.Verify(message, sig))
+
+			restored, err := PrivateKeyFromBytes(parentPrivate.Bytes())
+			require.NoError(t, err)
+			assert.Equal(t, parentPrivate, restored)
+		})
+	}
+}
+
+func TestDeriveSoftPublicKey(t *testing.T) {
+	root, err := PrivateKeyFromBytes(subkeySeed)
+	require.NoError(t, err)
+
+	got, err := DeriveSoftPublicKey(root.PublicKey(), chaincode.ChainCodeFromDeriveIndexString("foo"))
+	require.NoError(t, err)
+	assert.Equal(t, encodingtest.MustDecodeHex("287061f5973551d070ccc62fb4563a0be2e6324ce183c456850e342aa021f94d"), got.Bytes())
+
+	_, err = DeriveSoftPublicKey(&PublicKey{key: make([]byte, 31)}, chaincode.ChainCodeFromDeriveIndexString("Alice"))
+	assert.Error(t, err)
+
+	_, err = DeriveSoftPublicKey(nil, chaincode.ChainCodeFromDeriveIndexString("Alice"))
+	assert.Error(t, err)
+}
+
+// https://github.com/Warchant/sr25519-crust/blob/master/test/derive.cpp, also used by go-schnorrkel.
+func TestDeriveKey_SchnorrkelVectors(t *testing.T) {
+	keyPair := encodingtest.MustDecodeHex("4c1250e05afcd79e74f6c035aee10248841090e009b6fd7ba6a98d5dc743250cafa4b32c608e3ee2ba624850b3f14c75841af84b16798bf1ee4a3875aa37a2cee661e416406384fe1ca091980958576d2bff7c461636e9f22c895f444905ea1f")
+
+	parent, err := PrivateKeyFromBytes(keyPair[:secretKeySize])
+	require.NoError(t, err)
+	assert.Equal(t, keyPair[secretKeySize:], parent.PublicKey().Bytes())
+
+	soft, err := DeriveSoftKey(parent, encodingtest.MustDecodeHex("0c666f6f00000000000000000000000000000000000000000000000000000000"))
+	require.NoError(t, err)
+	assert.Equal(t, encodingtest.MustDecodeHex("b21e5aabeeb35d6a1bf76226a6c65cd897016df09ef208243e59eed2401f5357"), soft.PublicKey().Bytes())
+
+	softPublic, err := DeriveSoftPublicKey(parent.PublicKey(), encodingtest.MustDecodeHex("0c666f6f00000000000000000000000000000000000000000000000000000000"))
+	require.NoError(t, err)
+	assert.Equal(t, soft.PublicKey().Bytes(), softPublic.Bytes())
+
+	hard, err := DeriveHardenedKey(parent, encodingtest.MustDecodeHex("14416c6963650000000000000000000000000000000000000000000000000000"))
+	require.NoError(t, err)
+	assert.Equal(t, encodingtest.MustDecodeHex("d8db757f04521a940f0237c8a1e44dfbe0b3e39af929eb2e9e257ba61b9a0a1a"), hard.PublicKey().Bytes())
+}
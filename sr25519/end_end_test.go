@@ -1,8 +1,10 @@
 package sr25519
 
 import (
+	"crypto/rand"
 	"testing"
 
+	"github.com/mailchain/go-crypto/merlin"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -57,3 +59,33 @@ func TestSignVerify(t *testing.T) {
 		})
 	}
 }
+
+func TestSignVerifyWithContext(t *testing.T) {
+	message := []byte("message")
+
+	sig, err := bobPrivateKey.SignWithContext([]byte("mailchain"), message)
+	assert.NoError(t, err)
+	assert.True(t, bobPublicKey.VerifyWithContext([]byte("mailchain"), message, sig))
+	assert.False(t, bobPublicKey.VerifyWithContext([]byte("substrate"), message, sig))
+	assert.False(t, bobPublicKey.Verify(message, sig))
+	assert.False(t, alicePublicKey.VerifyWithContext([]byte("mailchain"), message, sig))
+
+	defaultSig, err := bobPrivateKey.Sign(message)
+	assert.NoError(t, err)
+	assert.True(t, bobPublicKey.VerifyWithContext([]byte("substrate"), message, defaultSig))
+}
+
+func TestSignVerifyTranscript(t *testing.T) {
+	newTranscript := func(extra string) *merlin.Transcript {
+		transcript := NewSigningTranscript([]byte("mailchain"), []byte("message"))
+		transcript.AppendMessage([]byte("extra"), []byte(extra))
+
+		return transcript
+	}
+
+	sig, err := bobPrivateKey.SignTranscript(rand.Reader, newTranscript("one"))
+	assert.NoError(t, err)
+	assert.True(t, bobPublicKey.VerifyTranscript(newTranscript("one"), sig))
+	assert.False(t, bobPublicKey.VerifyTranscript(newTranscript("two"), sig))
+	assert.False(t, bobPublicKey.VerifyWithContext([]byte("mailchain"), []byte("message"), sig))
+}
@@ -0,0 +1,97 @@
+package sr25519
+
+import (
+	"github.com/mailchain/go-crypto"
+	"github.com/mailchain/go-crypto/chaincode"
+)
+
+// HardenedKeyStart is the index at which hard derivation begins. Indexes at or above this value use hard derivation
+// with the junction `index - HardenedKeyStart`, indexes below use soft derivation with the junction `index`.
+// For example Derive(HardenedKeyStart + 1) matches `//1` in substrate and Derive(1) matches `/1`.
+const HardenedKeyStart uint32 = 0x80000000
+
+// ExtendedPrivateKey is a sr25519 private key that supports schnorrkel hard and soft derivation.
+type ExtendedPrivateKey struct {
+	key PrivateKey
+}
+
+// NewExtendedPrivateKey creates an extended private key from the private key.
+func NewExtendedPrivateKey(key *PrivateKey) *ExtendedPrivateKey {
+	return &ExtendedPrivateKey{key: *key}
+}
+
+// Bytes returns the byte representation of the private key.
+func (k ExtendedPrivateKey) Bytes() []byte {
+	return k.key.Bytes()
+}
+
+// PrivateKey returns the private key.
+func (k ExtendedPrivateKey) PrivateKey() crypto.PrivateKey {
+	return &k.key
+}
+
+// Derive the child extended private key at index.
+// Hard derivation is used when index is equal to or greater than HardenedKeyStart, soft derivation otherwise.
+func (k ExtendedPrivateKey) Derive(index uint32) (crypto.ExtendedPrivateKey, error) {
+	var (
+		child *PrivateKey
+		err   error
+	)
+
+	if index >= HardenedKeyStart {
+		child, err = DeriveHardenedKey(&k.key, chaincode.ChainCodeFromDeriveIndexUint64(uint64(index-HardenedKeyStart)))
+	} else {
+		child, err = DeriveSoftKey(&k.key, chaincode.ChainCodeFromDeriveIndexUint64(uint64(index)))
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return NewExtendedPrivateKey(child), nil
+}
+
+// ExtendedPublicKey returns the extended public key that corresponds to this extended private key.
+func (k ExtendedPrivateKey) ExtendedPublicKey() (crypto.ExtendedPublicKey, error) {
+	pub, err := publicKey(k.key.PublicKey())
+	if err != nil {
+		return nil, err
+	}
+
+	return NewExtendedPublicKey(pub), nil
+}
+
+// ExtendedPublicKey is a sr25519 public key that supports schnorrkel soft derivation.
+type ExtendedPublicKey struct {
+	key PublicKey
+}
+
+// NewExtendedPublicKey creates an extended public key from the public key.
+func NewExtendedPublicKey(key *PublicKey) *ExtendedPublicKey {
+	return &ExtendedPublicKey{key: *key}
+}
+
+// Bytes returns the byte representation of the public key.
+func (k ExtendedPublicKey) Bytes() []byte {
+	return k.key.Bytes()
+}
+
+// PublicKey returns the public key.
+func (k ExtendedPublicKey) PublicKey() crypto.PublicKey {
+	return &k.key
+}
+
+// Derive the soft derived child extended public key at index.
+// ErrDeriveHardenedFromPublic is returned when index is equal to or greater than HardenedKeyStart.
+func (k ExtendedPublicKey) Derive(index uint32) (crypto.ExtendedPublicKey, error) {
+	if index >= HardenedKeyStart {
+		return nil, ErrDeriveHardenedFromPublic
+	}
+
+	child, err := DeriveSoftPublicKey(&k.key, chaincode.ChainCodeFromDeriveIndexUint64(uint64(index)))
+	if err != nil {
+		return nil, err
+	}
+
+	return NewExtendedPublicKey(child), nil
+}
@@ -0,0 +1,49 @@
+package sr25519
+
+import (
+	"testing"
+
+	"github.com/mailchain/go-crypto/chaincode"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtendedPrivateKey_Derive(t *testing.T) {
+	root, err := PrivateKeyFromBytes(devSeed)
+	require.NoError(t, err)
+	extended := NewExtendedPrivateKey(root)
+
+	hard, err := extended.Derive(HardenedKeyStart + 1)
+	require.NoError(t, err)
+	wantHard, err := DeriveHardenedKey(root, chaincode.ChainCodeFromDeriveIndexUint64(1))
+	require.NoError(t, err)
+	assert.Equal(t, wantHard.Bytes(), hard.Bytes())
+
+	soft, err := extended.Derive(1)
+	require.NoError(t, err)
+	wantSoft, err := DeriveSoftPublicKey(root.PublicKey(), chaincode.ChainCodeFromDeriveIndexUint64(1))
+	require.NoError(t, err)
+	assert.Equal(t, wantSoft.Bytes(), soft.PrivateKey().PublicKey().Bytes())
+}
+
+func TestExtendedPublicKey_Derive(t *testing.T) {
+	root, err := PrivateKeyFromBytes(devSeed)
+	require.NoError(t, err)
+	extended := NewExtendedPrivateKey(root)
+
+	extendedPublic, err := extended.ExtendedPublicKey()
+	require.NoError(t, err)
+	assert.Equal(t, root.PublicKey().Bytes(), extendedPublic.Bytes())
+
+	for _, index := range []uint32{0, 1, 42} {
+		privateChild, err := extended.Derive(index)
+		require.NoError(t, err)
+		publicChild, err := extendedPublic.Derive(index)
+		require.NoError(t, err)
+
+		assert.Equal(t, privateChild.PrivateKey().PublicKey().Bytes(), publicChild.PublicKey().Bytes())
+	}
+
+	_, err = extendedPublic.Derive(HardenedKeyStart)
+	assert.Equal(t, ErrDeriveHardenedFromPublic, err)
+}
@@ -1,17 +1,20 @@
 package sr25519
 
 import (
+	"crypto/rand"
 	"fmt"
 	"io"
 
-	"github.com/gtank/merlin"
 	"github.com/gtank/ristretto255"
 	"github.com/mailchain/go-crypto"
 	"github.com/mailchain/go-crypto/internal/schnorrkel"
+	"github.com/mailchain/go-crypto/merlin"
 )
 
 const (
 	seedSize = 32
+	// secretKeySize is the size of a secret key without a seed, the secret scalar followed by the nonce.
+	secretKeySize = 64
 )
 
 func GenerateKey(rand io.Reader) (*PrivateKey, error) {
@@ -28,9 +31,14 @@ type PrivateKey struct {
 	secretKey schnorrkel.SecretKey
 }
 
-// Bytes returns the byte representation of the private key
+// Bytes returns the byte representation of the private key.
+// The seed is returned when the key was created from a seed, otherwise the 64 byte secret scalar followed by the nonce is returned.
 func (pk PrivateKey) Bytes() []byte {
-	return pk.secretKey.Seed()
+	if seed := pk.secretKey.Seed(); seed != nil {
+		return seed
+	}
+
+	return pk.secretKey.Bytes()
 }
 
 // PublicKey return the crypto.PublicKey that is derived from the Privatekey
@@ -43,14 +51,32 @@ func (pk PrivateKey) PublicKey() crypto.PublicKey {
 	return &PublicKey{key: ristretto255.NewElement().ScalarBaseMult(key).Encode([]byte{})}
 }
 
-// Sign uses the PrivateKey to sign the message using the sr25519 signature algorithm
+// Sign uses the PrivateKey to sign the message using the sr25519 signature algorithm with the `substrate` signing context.
 func (pk PrivateKey) Sign(message []byte) ([]byte, error) {
-	context := newSigningContext(substrateContext, message)
+	return pk.SignWithRand(rand.Reader, message)
+}
+
+// SignWithRand signs the message using the sr25519 signature algorithm with randomness read from rand.
+// The witness is derived from the transcript, the key nonce and 32 bytes read from rand as in schnorrkel,
+// so a weak rand does not leak the key and a fixed rand results in reproducible signatures.
+func (pk PrivateKey) SignWithRand(rand io.Reader, message []byte) ([]byte, error) {
+	return pk.SignTranscript(rand, NewSigningTranscript(substrateContext, message))
+}
+
+// SignWithContext signs the message in the signing context, signatures from other contexts do not verify.
+func (pk PrivateKey) SignWithContext(context, message []byte) ([]byte, error) {
+	return pk.SignTranscript(rand.Reader, NewSigningTranscript(context, message))
+}
+
+// SignTranscript signs the transcript, it is usually created by NewSigningTranscript.
+// The transcript is modified and must not be reused.
+func (pk PrivateKey) SignTranscript(rand io.Reader, transcript *merlin.Transcript) ([]byte, error) {
+	context := signingContext{transcript}
 
 	context.AppendMessage([]byte("proto-name"), []byte("Schnorr-sig")) // https://github.com/w3f/schnorrkel/blob/4112f6e8cb684a1cc6574f9097497e1e302ab9a8/src/sign.rs#L173
 	context.AppendMessage([]byte("sign:pk"), pk.PublicKey().Bytes())   // https://github.com/w3f/schnorrkel/blob/4112f6e8cb684a1cc6574f9097497e1e302ab9a8/src/sign.rs#L174
 
-	r, err := witness(pk.secretKey.Nonce()) // witness_scalar Not implemented https://github.com/w3f/schnorrkel/blob/4112f6e8cb684a1cc6574f9097497e1e302ab9a8/src/sign.rs#L176
+	r, err := schnorrkel.WitnessScalar(context.Transcript, []byte("signing"), [][]byte{pk.secretKey.Nonce()}, rand) // https://github.com/w3f/schnorrkel/blob/4112f6e8cb684a1cc6574f9097497e1e302ab9a8/src/sign.rs#L176
 	if err != nil {
 		return nil, err
 	}
@@ -71,7 +97,7 @@ func (pk PrivateKey) Sign(message []byte) ([]byte, error) {
 	return sig.Encode(), nil
 }
 
-// PrivateKeyFromBytes get a private key from seed []byte
+// PrivateKeyFromBytes get a private key from seed []byte or a 64 byte secret key as returned by Bytes for keys without a seed.
 func PrivateKeyFromBytes(privKey []byte) (*PrivateKey, error) {
 	switch len(privKey) {
 	case seedSize:
@@ -79,6 +105,17 @@ func PrivateKeyFromBytes(privKey []byte) (*PrivateKey, error) {
 		copy(seed[:], privKey)
 
 		return &PrivateKey{secretKey: schnorrkel.NewSecretKeySR25519(seed)}, nil
+	case secretKeySize:
+		if err := ristretto255.NewScalar().Decode(privKey[:32]); err != nil {
+			return nil, fmt.Errorf("sr25519: invalid secret key: %w", err)
+		}
+
+		key := [32]byte{}
+		nonce := [32]byte{}
+		copy(key[:], privKey[:32])
+		copy(nonce[:], privKey[32:])
+
+		return &PrivateKey{secretKey: schnorrkel.NewSecretKey(key, nonce)}, nil
 	default:
 		return nil, fmt.Errorf("sr25519: bad key length")
 	}
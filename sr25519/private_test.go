@@ -260,3 +260,30 @@ func TestExchangeKeys(t *testing.T) {
 		})
 	}
 }
+
+func TestPrivateKey_SignWithRand(t *testing.T) {
+	zeroRand := func() io.Reader { return bytes.NewReader(make([]byte, 32)) }
+
+	got, err := bobPrivateKey.SignWithRand(zeroRand(), []byte("message"))
+	assert.NoError(t, err)
+
+	again, err := bobPrivateKey.SignWithRand(zeroRand(), []byte("message"))
+	assert.NoError(t, err)
+	assert.Equal(t, got, again, "the same rand must give the same signature")
+	assert.True(t, bobPublicKey.Verify([]byte("message"), again))
+
+	otherMessage, err := bobPrivateKey.SignWithRand(zeroRand(), []byte("egassem"))
+	assert.NoError(t, err)
+	assert.NotEqual(t, got[:32], otherMessage[:32], "the witness must depend on the message")
+
+	otherKey, err := alicePrivateKey.SignWithRand(zeroRand(), []byte("message"))
+	assert.NoError(t, err)
+	assert.NotEqual(t, got[:32], otherKey[:32], "the witness must depend on the key")
+
+	otherRand, err := bobPrivateKey.SignWithRand(bytes.NewReader(bytes.Repeat([]byte{1}, 32)), []byte("message"))
+	assert.NoError(t, err)
+	assert.NotEqual(t, got, otherRand, "the witness must depend on rand")
+
+	_, err = bobPrivateKey.SignWithRand(bytes.NewReader(make([]byte, 31)), []byte("message"))
+	assert.Error(t, err)
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/gtank/ristretto255"
 	"github.com/mailchain/go-crypto"
+	"github.com/mailchain/go-crypto/merlin"
 )
 
 const (
@@ -22,20 +23,26 @@ func (pk PublicKey) Bytes() []byte {
 }
 
 // Verify uses the sr25519 signature algorithm to verify that the message was signed by
-// this public key; it returns true if this key created the signature for the message,
+// this public key with the `substrate` signing context; it returns true if this key created the signature for the message,
 // false otherwise
 func (pk PublicKey) Verify(message, sig []byte) bool {
+	return pk.VerifyWithContext(substrateContext, message, sig)
+}
+
+// VerifyWithContext verifies that the message was signed by this public key in the signing context.
+func (pk PublicKey) VerifyWithContext(context, message, sig []byte) bool {
+	return pk.VerifyTranscript(NewSigningTranscript(context, message), sig)
+}
+
+// VerifyTranscript verifies that the transcript was signed by this public key.
+// The transcript must contain the same messages as the transcript that was signed, it is modified and must not be reused.
+func (pk PublicKey) VerifyTranscript(transcript *merlin.Transcript, sig []byte) bool {
 	signature := signature{}
 	if err := signature.Decode(sig); err != nil {
 		return false
 	}
 
-	context := newSigningContext(substrateContext, message)
-	context.AppendMessage([]byte("proto-name"), []byte("Schnorr-sig"))
-	context.AppendMessage([]byte("sign:pk"), pk.key)                      // https://github.com/w3f/schnorrkel/blob/4112f6e8cb684a1cc6574f9097497e1e302ab9a8/src/sign.rs#L212
-	context.AppendMessage([]byte("sign:R"), signature.R.Encode([]byte{})) // https://github.com/w3f/schnorrkel/blob/4112f6e8cb684a1cc6574f9097497e1e302ab9a8/src/sign.rs#L213
-
-	k := context.challengeScalar([]byte("sign:c")) // https://github.com/w3f/schnorrkel/blob/4112f6e8cb684a1cc6574f9097497e1e302ab9a8/src/sign.rs#L215
+	k := pk.challenge(transcript, signature.R)
 	// https://github.com/w3f/schnorrkel/blob/4112f6e8cb684a1cc6574f9097497e1e302ab9a8/src/sign.rs#L216
 	a := ristretto255.NewElement()
 	if err := a.Decode(pk.key); err != nil {
@@ -50,6 +57,15 @@ func (pk PublicKey) Verify(message, sig []byte) bool {
 	return Rp.Equal(signature.R) == 1
 }
 
+func (pk PublicKey) challenge(transcript *merlin.Transcript, R *ristretto255.Element) *ristretto255.Scalar {
+	context := signingContext{transcript}
+	context.AppendMessage([]byte("proto-name"), []byte("Schnorr-sig"))
+	context.AppendMessage([]byte("sign:pk"), pk.key)            // https://github.com/w3f/schnorrkel/blob/4112f6e8cb684a1cc6574f9097497e1e302ab9a8/src/sign.rs#L212
+	context.AppendMessage([]byte("sign:R"), R.Encode([]byte{})) // https://github.com/w3f/schnorrkel/blob/4112f6e8cb684a1cc6574f9097497e1e302ab9a8/src/sign.rs#L213
+
+	return context.challengeScalar([]byte("sign:c")) // https://github.com/w3f/schnorrkel/blob/4112f6e8cb684a1cc6574f9097497e1e302ab9a8/src/sign.rs#L215
+}
+
 // PublicKeyFromBytes - Convert byte array to PublicKey
 func PublicKeyFromBytes(keyBytes []byte) (crypto.PublicKey, error) {
 	switch len(keyBytes) {
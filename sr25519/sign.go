@@ -1,12 +1,10 @@
 package sr25519
 
 import (
-	"crypto/rand"
 	"errors"
-	"io"
 
-	"github.com/gtank/merlin"
 	"github.com/gtank/ristretto255"
+	"github.com/mailchain/go-crypto/merlin"
 )
 
 var substrateContext = []byte("substrate") //nolint gochecknoglobals
@@ -18,12 +16,14 @@ type signingContext struct {
 	*merlin.Transcript
 }
 
-func newSigningContext(context, msg []byte) signingContext {
+// NewSigningTranscript returns the transcript that is signed for the message in the signing context.
+// Protocols can append extra messages to the transcript before it is passed to SignTranscript or VerifyTranscript.
+func NewSigningTranscript(context, message []byte) *merlin.Transcript {
 	transcript := merlin.NewTranscript("SigningContext")
 	transcript.AppendMessage([]byte(""), context)
-	transcript.AppendMessage([]byte("sign-bytes"), msg)
+	transcript.AppendMessage([]byte("sign-bytes"), message)
 
-	return signingContext{transcript}
+	return transcript
 }
 
 func (c *signingContext) challengeScalar(label []byte) *ristretto255.Scalar {
@@ -33,18 +33,6 @@ func (c *signingContext) challengeScalar(label []byte) *ristretto255.Scalar {
 	return k.FromUniformBytes(b)
 }
 
-func witness(nonce []byte) (*ristretto255.Scalar, error) {
-	_ = nonce
-	b := make([]byte, signatureSize)
-
-	_, err := io.ReadFull(rand.Reader, b)
-	if err != nil {
-		return nil, err
-	}
-
-	return ristretto255.NewScalar().FromUniformBytes(b), nil
-}
-
 type signature struct {
 	R *ristretto255.Element
 	S *ristretto255.Scalar
@@ -73,8 +61,11 @@ func (s *signature) Decode(sig []byte) error { // https://github.com/w3f/schnorr
 		return errors.New("signature not marked as schnorrkel")
 	}
 
-	sig[63] &= 127
+	// clear the marker on a copy so the caller's signature is not modified
+	scalar := make([]byte, 32)
+	copy(scalar, sig[32:])
+	scalar[31] &= 127
 	s.S = ristretto255.NewScalar()
 
-	return s.S.Decode(sig[32:])
+	return s.S.Decode(scalar)
 }
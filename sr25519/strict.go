@@ -0,0 +1,24 @@
+package sr25519
+
+import (
+	"github.com/gtank/ristretto255"
+)
+
+// VerifyStrict verifies whether sig is a valid signature of message with the `substrate` signing context,
+// rejecting weak public keys.
+// It is the recommended verification where every party must agree on which signatures are valid, for example consensus.
+// Signatures and public keys are canonically encoded ristretto255 scalars and points so, in addition to the checks of
+// Verify, the public key must not be the identity for which anyone could create a signature.
+func (pk PublicKey) VerifyStrict(message, sig []byte) bool {
+	return pk.VerifyStrictWithContext(substrateContext, message, sig)
+}
+
+// VerifyStrictWithContext verifies whether sig is a valid signature of message in the signing context, see VerifyStrict.
+func (pk PublicKey) VerifyStrictWithContext(context, message, sig []byte) bool {
+	a := ristretto255.NewElement()
+	if err := a.Decode(pk.key); err != nil || a.Equal(ristretto255.NewElement().Zero()) == 1 {
+		return false
+	}
+
+	return pk.VerifyWithContext(context, message, sig)
+}
@@ -0,0 +1,95 @@
+package sr25519
+
+import (
+	"testing"
+
+	"github.com/mailchain/go-crypto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPublicKey_VerifyStrict(t *testing.T) {
+	message := []byte("message")
+
+	sig, err := alicePrivateKey.Sign(message)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	// R is the identity, S is zero and the schnorrkel marker is set
+	identitySig := make([]byte, signatureSize)
+	identitySig[63] = 128
+
+	tests := []struct {
+		name          string
+		pk            PublicKey
+		sig           []byte
+		want          bool
+		wantNonStrict bool
+	}{
+		{
+			"valid",
+			alicePublicKey,
+			sig,
+			true,
+			true,
+		},
+		{
+			"err-wrong-key",
+			bobPublicKey,
+			sig,
+			false,
+			false,
+		},
+		{
+			"err-identity-public-key",
+			PublicKey{key: make([]byte, publicKeySize)},
+			identitySig,
+			false,
+			true,
+		},
+		{
+			"err-missing-marker",
+			alicePublicKey,
+			append(append([]byte{}, sig[:63]...), sig[63]&127),
+			false,
+			false,
+		},
+		{
+			"err-short-signature",
+			alicePublicKey,
+			sig[:63],
+			false,
+			false,
+		},
+		{
+			"err-short-public-key",
+			PublicKey{key: alicePublicKeyBytes[:31]},
+			sig,
+			false,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			original := append([]byte{}, tt.sig...)
+			assert.Equal(t, tt.want, tt.pk.VerifyStrict(message, tt.sig))
+			assert.Equal(t, tt.want, crypto.VerifyStrict(tt.pk, message, tt.sig))
+			assert.Equal(t, tt.wantNonStrict, tt.pk.Verify(message, tt.sig))
+			assert.Equal(t, original, tt.sig)
+		})
+	}
+}
+
+func TestSignature_DecodeDoesNotModifyInput(t *testing.T) {
+	sig, err := alicePrivateKey.Sign([]byte("message"))
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	original := append([]byte{}, sig...)
+	decoded := signature{}
+
+	assert.NoError(t, decoded.Decode(sig))
+	assert.Equal(t, original, sig)
+	assert.Equal(t, original, decoded.Encode())
+}
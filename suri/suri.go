@@ -0,0 +1,208 @@
+// Package suri parses substrate secret URIs and derives the private keys they describe.
+// A secret URI is a phrase or hex encoded seed followed by `//hard` and `/soft` junctions
+// and an optional `///password`, for example `//Alice` or `0x...//polkadot/0///secret`.
+package suri
+
+import (
+	"encoding/hex"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/mailchain/go-crypto"
+	"github.com/mailchain/go-crypto/chaincode"
+	"github.com/mailchain/go-crypto/ed25519"
+	"github.com/mailchain/go-crypto/mnemonic"
+	"github.com/mailchain/go-crypto/secp256k1"
+	"github.com/mailchain/go-crypto/sr25519"
+)
+
+// DevPhrase is the substrate development phrase, it is used when a secret URI has no phrase.
+const DevPhrase = "bottom drive obey lake curtain smoke basket hold race lonely fit walk"
+
+const seedLength = 32
+
+var (
+	// ErrInvalidSecretURI is returned when a secret URI can not be parsed.
+	ErrInvalidSecretURI = errors.New("invalid secret uri")
+	// ErrInvalidSeed is returned when a hex seed is not a 32 byte hex encoded value.
+	ErrInvalidSeed = errors.New("seed must be 32 bytes hex encoded with 0x prefix")
+	// ErrUnsupportedKind is returned when keys of the requested kind can not be derived.
+	ErrUnsupportedKind = errors.New("key kind is not supported")
+	// ErrSoftDerivationNotSupported is returned when a soft junction is applied to a key that only supports hard derivation.
+	ErrSoftDerivationNotSupported = errors.New("soft derivation is not supported for key kind")
+)
+
+// Junction is a single derivation step of a secret URI.
+type Junction struct {
+	// Hard is true for `//hard` junctions and false for `/soft` junctions.
+	Hard bool
+	// ChainCode is the 32 byte chain code of the junction.
+	ChainCode []byte
+}
+
+// NewJunction creates a junction the same way as substrate, numeric junctions are encoded as a
+// little endian uint64 and all other junctions are encoded as a SCALE string.
+func NewJunction(index string, hard bool) Junction {
+	if number, err := strconv.ParseUint(index, 10, 64); err == nil {
+		return Junction{Hard: hard, ChainCode: chaincode.ChainCodeFromDeriveIndexUint64(number)}
+	}
+
+	return Junction{Hard: hard, ChainCode: chaincode.ChainCodeFromDeriveIndexString(index)}
+}
+
+// SecretURI is a parsed substrate secret URI.
+type SecretURI struct {
+	// Phrase is the mnemonic phrase or `0x` prefixed hex seed, an empty phrase means DevPhrase.
+	Phrase string
+	// Junctions are applied in order to the root key.
+	Junctions []Junction
+	// Password is used together with the phrase to create the root key, it is ignored for hex seeds.
+	Password string
+}
+
+// Parse a secret URI in the form `phrase//hard/soft///password`.
+// An empty password, `phrase///`, is accepted like substrate and is the same as no password.
+func Parse(in string) (*SecretURI, error) {
+	rest, password, _ := strings.Cut(in, "///")
+
+	phrase, path := rest, ""
+	if i := strings.Index(rest, "/"); i >= 0 {
+		phrase, path = rest[:i], rest[i:]
+	}
+
+	junctions, err := parsePath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SecretURI{
+		Phrase:    strings.TrimSpace(phrase),
+		Junctions: junctions,
+		Password:  password,
+	}, nil
+}
+
+func parsePath(path string) ([]Junction, error) {
+	junctions := []Junction{}
+
+	for path != "" {
+		hard := strings.HasPrefix(path, "//")
+		path = strings.TrimPrefix(strings.TrimPrefix(path, "/"), "/")
+
+		index := path
+		if i := strings.Index(path, "/"); i >= 0 {
+			index, path = path[:i], path[i:]
+		} else {
+			path = ""
+		}
+
+		if index == "" {
+			return nil, ErrInvalidSecretURI
+		}
+
+		junctions = append(junctions, NewJunction(index, hard))
+	}
+
+	return junctions, nil
+}
+
+// PrivateKey creates the root key for kind from the phrase and password then applies the junctions.
+// Supported kinds are crypto.KindED25519, crypto.KindSR25519 and crypto.KindSECP256K1.
+func (u SecretURI) PrivateKey(kind string) (crypto.PrivateKey, error) {
+	seed, err := u.seed()
+	if err != nil {
+		return nil, err
+	}
+
+	root, err := rootKey(kind, seed)
+	if err != nil {
+		return nil, err
+	}
+
+	return Derive(root, u.Junctions)
+}
+
+// PrivateKeyFromString parses the secret URI and returns the private key of kind that it describes.
+func PrivateKeyFromString(in, kind string) (crypto.PrivateKey, error) {
+	u, err := Parse(in)
+	if err != nil {
+		return nil, err
+	}
+
+	return u.PrivateKey(kind)
+}
+
+// Derive applies the junctions in order to parent.
+// ed25519 and secp256k1 keys only support hard junctions, sr25519 keys support hard and soft junctions.
+func Derive(parent crypto.PrivateKey, junctions []Junction) (crypto.PrivateKey, error) {
+	key := parent
+
+	for _, j := range junctions {
+		var err error
+
+		key, err = deriveJunction(key, j)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return key, nil
+}
+
+func deriveJunction(parent crypto.PrivateKey, j Junction) (crypto.PrivateKey, error) {
+	switch parent.(type) {
+	case *ed25519.PrivateKey, ed25519.PrivateKey:
+		if !j.Hard {
+			return nil, ErrSoftDerivationNotSupported
+		}
+
+		return ed25519.DeriveHardenedKey(parent, j.ChainCode)
+	case *sr25519.PrivateKey, sr25519.PrivateKey:
+		if !j.Hard {
+			return sr25519.DeriveSoftKey(parent, j.ChainCode)
+		}
+
+		return sr25519.DeriveHardenedKey(parent, j.ChainCode)
+	case *secp256k1.PrivateKey, secp256k1.PrivateKey:
+		if !j.Hard {
+			return nil, ErrSoftDerivationNotSupported
+		}
+
+		return secp256k1.DeriveHardenedKey(parent, j.ChainCode)
+	default:
+		return nil, ErrUnsupportedKind
+	}
+}
+
+func (u SecretURI) seed() ([]byte, error) {
+	if strings.HasPrefix(u.Phrase, "0x") {
+		// substrate ignores the password when the root key is created from a seed.
+		seed, err := hex.DecodeString(u.Phrase[2:])
+		if err != nil || len(seed) != seedLength {
+			return nil, ErrInvalidSeed
+		}
+
+		return seed, nil
+	}
+
+	phrase := u.Phrase
+	if phrase == "" {
+		phrase = DevPhrase
+	}
+
+	return mnemonic.ToMiniSecret(phrase, u.Password)
+}
+
+func rootKey(kind string, seed []byte) (crypto.PrivateKey, error) {
+	switch kind {
+	case crypto.KindED25519:
+		return ed25519.PrivateKeyFromBytes(seed)
+	case crypto.KindSR25519:
+		return sr25519.PrivateKeyFromBytes(seed)
+	case crypto.KindSECP256K1:
+		return secp256k1.PrivateKeyFromBytes(seed)
+	default:
+		return nil, ErrUnsupportedKind
+	}
+}
@@ -0,0 +1,288 @@
+package suri
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/mailchain/go-crypto"
+	"github.com/mailchain/go-crypto/chaincode"
+	"github.com/mailchain/go-crypto/mnemonic"
+	"github.com/mailchain/go-encoding/encodingtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      string
+		want    *SecretURI
+		wantErr error
+	}{
+		{
+			"dev-phrase-hard",
+			"//Alice",
+			&SecretURI{
+				Phrase:    "",
+				Junctions: []Junction{{Hard: true, ChainCode: chaincode.ChainCodeFromDeriveIndexString("Alice")}},
+			},
+			nil,
+		},
+		{
+			"phrase-hard-soft-password",
+			"bottom drive obey lake curtain smoke basket hold race lonely fit walk//polkadot/0///secret/pass",
+			&SecretURI{
+				Phrase: DevPhrase,
+				Junctions: []Junction{
+					{Hard: true, ChainCode: chaincode.ChainCodeFromDeriveIndexString("polkadot")},
+					{Hard: false, ChainCode: chaincode.ChainCodeFromDeriveIndexUint64(0)},
+				},
+				Password: "secret/pass",
+			},
+			nil,
+		},
+		{
+			"hex-seed",
+			"0xfac7959dbfe72f052e5a0c3c8d6530f202b02fd8f9f5ca3580ec8deb7797479e",
+			&SecretURI{
+				Phrase:    "0xfac7959dbfe72f052e5a0c3c8d6530f202b02fd8f9f5ca3580ec8deb7797479e",
+				Junctions: []Junction{},
+			},
+			nil,
+		},
+		{
+			"password-only",
+			"///password",
+			&SecretURI{
+				Junctions: []Junction{},
+				Password:  "password",
+			},
+			nil,
+		},
+		{
+			"err-empty-junction",
+			"//Alice//",
+			nil,
+			ErrInvalidSecretURI,
+		},
+		{
+			"empty-password",
+			"//Alice///",
+			&SecretURI{
+				Junctions: []Junction{NewJunction("Alice", true)},
+			},
+			nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(tt.in)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestPrivateKeyFromString(t *testing.T) {
+	tests := []struct {
+		name          string
+		in            string
+		kind          string
+		wantPublicKey []byte
+		wantErr       error
+	}{
+		{
+			"sr25519-dev",
+			"",
+			crypto.KindSR25519,
+			encodingtest.MustDecodeHex("46ebddef8cd9bb167dc30878d7113b7e168e6f0646beffd77d69d39bad76b47a"),
+			nil,
+		},
+		{
+			"sr25519-alice",
+			"//Alice",
+			crypto.KindSR25519,
+			encodingtest.MustDecodeHex("d43593c715fdd31c61141abd04a99fd6822c8558854ccde39a5684e7a56da27d"),
+			nil,
+		},
+		{
+			"sr25519-alice-stash",
+			"//Alice//stash",
+			crypto.KindSR25519,
+			encodingtest.MustDecodeHex("be5ddb1579b72e84524fc29e78609e3caf42e85aa118ebfe0b0ad404b5bdd25f"),
+			nil,
+		},
+		{
+			"sr25519-alice-empty-password",
+			"//Alice///",
+			crypto.KindSR25519,
+			encodingtest.MustDecodeHex("d43593c715fdd31c61141abd04a99fd6822c8558854ccde39a5684e7a56da27d"),
+			nil,
+		},
+		// subkey vectors from https://github.com/vedhavyas/go-subkey/blob/v1.0.3/scheme_test.go, the seed is the
+		// mini secret key of `crowd swamp sniff machine grid pretty client emotion banana cricket flush soap`.
+		{
+			"sr25519-subkey-soft",
+			"0x18446f2d685492c3086391aabe8f5e235c3c2e02521985650f0c97052237e717/foo",
+			crypto.KindSR25519,
+			encodingtest.MustDecodeHex("287061f5973551d070ccc62fb4563a0be2e6324ce183c456850e342aa021f94d"),
+			nil,
+		},
+		{
+			"sr25519-subkey-hard-soft",
+			"0x18446f2d685492c3086391aabe8f5e235c3c2e02521985650f0c97052237e717//foo/bar//42/69",
+			crypto.KindSR25519,
+			encodingtest.MustDecodeHex("68a5a8f7e29ffcae1d15518b180f6e4f1132b45ffd565cb7953045faf07c8809"),
+			nil,
+		},
+		{
+			"ed25519-subkey-hard",
+			"0x18446f2d685492c3086391aabe8f5e235c3c2e02521985650f0c97052237e717//foo//42",
+			crypto.KindED25519,
+			encodingtest.MustDecodeHex("7a16bd534b1aab9d420d5ca544927ccff88f76e39b063faee502b63f7a2fb394"),
+			nil,
+		},
+		{
+			"secp256k1-subkey-hard",
+			"0x18446f2d685492c3086391aabe8f5e235c3c2e02521985650f0c97052237e717//foo//42",
+			crypto.KindSECP256K1,
+			encodingtest.MustDecodeHex("0357af8e3e095a0f348fef65b78839a8dc4b4c959f24c4a5a0125f3989cc0a90d0"),
+			nil,
+		},
+		{
+			"sr25519-subkey-hard-soft-password",
+			"crowd swamp sniff machine grid pretty client emotion banana cricket flush soap//foo/bar//42/69///password",
+			crypto.KindSR25519,
+			encodingtest.MustDecodeHex("4055514cd4ddcc7b23024839b68190f3f71bc262eb038145262bfe087bbb5429"),
+			nil,
+		},
+		{
+			"ed25519-subkey-hard-password",
+			"crowd swamp sniff machine grid pretty client emotion banana cricket flush soap//foo//42///password",
+			crypto.KindED25519,
+			encodingtest.MustDecodeHex("34f7460f79c0c4947dfe1b4176ff8cf974883ed2f2a5c716ed89bd16b11e05dc"),
+			nil,
+		},
+		{
+			"secp256k1-subkey-hard-password",
+			"crowd swamp sniff machine grid pretty client emotion banana cricket flush soap//foo//42///password",
+			crypto.KindSECP256K1,
+			encodingtest.MustDecodeHex("0220bf156d0432c5abe371b1c46b6eef730668405957ed044a64b7f926fd90c6a3"),
+			nil,
+		},
+		{
+			"sr25519-hex-seed-alice",
+			"0xfac7959dbfe72f052e5a0c3c8d6530f202b02fd8f9f5ca3580ec8deb7797479e//Alice",
+			crypto.KindSR25519,
+			encodingtest.MustDecodeHex("d43593c715fdd31c61141abd04a99fd6822c8558854ccde39a5684e7a56da27d"),
+			nil,
+		},
+		{
+			"ed25519-alice",
+			"//Alice",
+			crypto.KindED25519,
+			encodingtest.MustDecodeHex("88dc3417d5058ec4b4503e0c12ea1a0a89be200fe98922423d4334014fa6b0ee"),
+			nil,
+		},
+		{
+			"secp256k1-alice",
+			"//Alice",
+			crypto.KindSECP256K1,
+			encodingtest.MustDecodeHex("020a1091341fe5664bfa1782d5e04779689068c916b04cb365ec3153755684d9a1"),
+			nil,
+		},
+		{
+			"err-ed25519-soft",
+			"/Alice",
+			crypto.KindED25519,
+			nil,
+			ErrSoftDerivationNotSupported,
+		},
+		{
+			"err-secp256k1-soft",
+			"//Alice/0",
+			crypto.KindSECP256K1,
+			nil,
+			ErrSoftDerivationNotSupported,
+		},
+		{
+			"err-secp256r1",
+			"//Alice",
+			crypto.KindSECP256R1,
+			nil,
+			ErrUnsupportedKind,
+		},
+		{
+			"err-phrase-checksum",
+			"bottom drive obey lake curtain smoke basket hold race lonely fit fit//Alice",
+			crypto.KindSR25519,
+			nil,
+			mnemonic.ErrInvalidChecksum,
+		},
+		{
+			"err-short-seed",
+			"0xfac7959d//Alice",
+			crypto.KindSR25519,
+			nil,
+			ErrInvalidSeed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := PrivateKeyFromString(tt.in, tt.kind)
+			assert.Equal(t, tt.wantErr, err)
+			if tt.wantErr != nil {
+				return
+			}
+			require.NotNil(t, got)
+			assert.Equal(t, tt.wantPublicKey, got.PublicKey().Bytes())
+		})
+	}
+}
+
+func TestPrivateKeyFromStringPassword(t *testing.T) {
+	miniSecret, err := mnemonic.ToMiniSecret(DevPhrase, "password")
+	require.NoError(t, err)
+
+	for _, kind := range []string{crypto.KindSR25519, crypto.KindED25519, crypto.KindSECP256K1} {
+		t.Run(kind, func(t *testing.T) {
+			got, err := PrivateKeyFromString(DevPhrase+"//Alice///password", kind)
+			require.NoError(t, err)
+			want, err := PrivateKeyFromString("0x"+hex.EncodeToString(miniSecret)+"//Alice", kind)
+			require.NoError(t, err)
+			assert.Equal(t, want.PublicKey().Bytes(), got.PublicKey().Bytes())
+
+			dev, err := PrivateKeyFromString("//Alice///password", kind)
+			require.NoError(t, err)
+			assert.Equal(t, want.PublicKey().Bytes(), dev.PublicKey().Bytes())
+
+			withoutPassword, err := PrivateKeyFromString("//Alice", kind)
+			require.NoError(t, err)
+			assert.NotEqual(t, withoutPassword.PublicKey().Bytes(), got.PublicKey().Bytes())
+
+			emptyPassword, err := PrivateKeyFromString("//Alice///", kind)
+			require.NoError(t, err)
+			assert.Equal(t, withoutPassword.PublicKey().Bytes(), emptyPassword.PublicKey().Bytes())
+		})
+	}
+}
+
+func TestPrivateKeyFromStringPhrase(t *testing.T) {
+	phrase := "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+	miniSecret, err := mnemonic.ToMiniSecret(phrase, "")
+	require.NoError(t, err)
+
+	for _, kind := range []string{crypto.KindSR25519, crypto.KindED25519, crypto.KindSECP256K1} {
+		t.Run(kind, func(t *testing.T) {
+			got, err := PrivateKeyFromString(phrase+"//Alice", kind)
+			require.NoError(t, err)
+			want, err := PrivateKeyFromString("0x"+hex.EncodeToString(miniSecret)+"//Alice", kind)
+			require.NoError(t, err)
+			assert.Equal(t, want.PublicKey().Bytes(), got.PublicKey().Bytes())
+
+			dev, err := PrivateKeyFromString("//Alice", kind)
+			require.NoError(t, err)
+			assert.NotEqual(t, dev.PublicKey().Bytes(), got.PublicKey().Bytes())
+		})
+	}
+}
@@ -0,0 +1,134 @@
+package webauthn
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/mailchain/go-crypto"
+)
+
+// Flags of the authenticator data, see https://www.w3.org/TR/webauthn-2/#flags.
+const (
+	// FlagUserPresent is set when the user was present.
+	FlagUserPresent byte = 1 << 0
+	// FlagUserVerified is set when the user was verified, for example with a PIN or biometric.
+	FlagUserVerified byte = 1 << 2
+	// FlagAttestedCredentialData is set when the authenticator data includes attested credential data.
+	FlagAttestedCredentialData byte = 1 << 6
+	// FlagExtensionData is set when the authenticator data includes extensions.
+	FlagExtensionData byte = 1 << 7
+)
+
+const (
+	rpIDHashSize           = 32
+	minAuthenticatorData   = rpIDHashSize + 1 + 4
+	aaguidSize             = 16
+	credentialIDLengthSize = 2
+)
+
+// AuthenticatorData is the data returned by the authenticator, see https://www.w3.org/TR/webauthn-2/#sctn-authenticator-data.
+type AuthenticatorData struct {
+	// RPIDHash is the SHA-256 hash of the relying party ID the credential is scoped to.
+	RPIDHash []byte
+	// Flags are the flags of the authenticator data.
+	Flags byte
+	// SignCount is the signature counter, authenticators that do not implement a counter return 0.
+	SignCount uint32
+	// AttestedCredentialData is included when FlagAttestedCredentialData is set, usually only on registration.
+	AttestedCredentialData *AttestedCredentialData
+	// Extensions is the CBOR encoded extension data when FlagExtensionData is set.
+	Extensions []byte
+}
+
+// AttestedCredentialData describes the credential that was created, see https://www.w3.org/TR/webauthn-2/#sctn-attested-credential-data.
+type AttestedCredentialData struct {
+	AAGUID       []byte
+	CredentialID []byte
+	// PublicKey is the credential public key parsed by ParseCOSEKey.
+	PublicKey crypto.PublicKey
+}
+
+// UserPresent returns true when the user present flag is set.
+func (a AuthenticatorData) UserPresent() bool {
+	return a.Flags&FlagUserPresent != 0
+}
+
+// UserVerified returns true when the user verified flag is set.
+func (a AuthenticatorData) UserVerified() bool {
+	return a.Flags&FlagUserVerified != 0
+}
+
+// ParseAuthenticatorData parses the binary authenticator data.
+func ParseAuthenticatorData(data []byte) (*AuthenticatorData, error) {
+	if len(data) < minAuthenticatorData {
+		return nil, fmt.Errorf("%w: must be at least %d bytes", ErrInvalidAuthenticatorData, minAuthenticatorData)
+	}
+
+	authData := &AuthenticatorData{
+		RPIDHash:  append([]byte{}, data[:rpIDHashSize]...),
+		Flags:     data[rpIDHashSize],
+		SignCount: binary.BigEndian.Uint32(data[rpIDHashSize+1 : minAuthenticatorData]),
+	}
+
+	rest := data[minAuthenticatorData:]
+
+	if authData.Flags&FlagAttestedCredentialData != 0 {
+		credentialData, remaining, err := parseAttestedCredentialData(rest)
+		if err != nil {
+			return nil, err
+		}
+
+		authData.AttestedCredentialData = credentialData
+		rest = remaining
+	}
+
+	if authData.Flags&FlagExtensionData != 0 {
+		var extensions cbor.RawMessage
+
+		remaining, err := cbor.UnmarshalFirst(rest, &extensions)
+		if err != nil {
+			return nil, fmt.Errorf("%w: extensions: %s", ErrInvalidAuthenticatorData, err)
+		}
+
+		authData.Extensions = extensions
+		rest = remaining
+	}
+
+	if len(rest) != 0 {
+		return nil, fmt.Errorf("%w: %d bytes of trailing data", ErrInvalidAuthenticatorData, len(rest))
+	}
+
+	return authData, nil
+}
+
+func parseAttestedCredentialData(data []byte) (*AttestedCredentialData, []byte, error) {
+	if len(data) < aaguidSize+credentialIDLengthSize {
+		return nil, nil, fmt.Errorf("%w: attested credential data is too short", ErrInvalidAuthenticatorData)
+	}
+
+	credentialIDLength := int(binary.BigEndian.Uint16(data[aaguidSize : aaguidSize+credentialIDLengthSize]))
+	rest := data[aaguidSize+credentialIDLengthSize:]
+
+	if len(rest) < credentialIDLength {
+		return nil, nil, fmt.Errorf("%w: credential ID is too short", ErrInvalidAuthenticatorData)
+	}
+
+	var rawKey cbor.RawMessage
+
+	remaining, err := cbor.UnmarshalFirst(rest[credentialIDLength:], &rawKey)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: credential public key: %s", ErrInvalidAuthenticatorData, err)
+	}
+
+	publicKey, err := ParseCOSEKey(rawKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &AttestedCredentialData{
+		AAGUID:       append([]byte{}, data[:aaguidSize]...),
+		CredentialID: append([]byte{}, rest[:credentialIDLength]...),
+		PublicKey:    publicKey,
+	}, remaining, nil
+}
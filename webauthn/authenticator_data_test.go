@@ -0,0 +1,119 @@
+package webauthn
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"github.com/mailchain/go-crypto/secp256r1/secp256r1test"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseAuthenticatorData(t *testing.T) {
+	rpIDHash := sha256.Sum256([]byte(testRPID))
+	aaguid := []byte("0123456789abcdef")
+	credentialID := []byte("credential-id")
+	publicKey := mustMarshalCBOR(es256COSEKey(secp256r1test.AlicePublicKey))
+	extensions := mustMarshalCBOR(map[string]bool{"credProtect": true})
+
+	header := func(flags byte) []byte {
+		return append(append([]byte{}, rpIDHash[:]...), flags, 0, 0, 1, 2)
+	}
+	attested := append(append(append(append([]byte{}, aaguid...), 0, byte(len(credentialID))), credentialID...), publicKey...)
+
+	tests := []struct {
+		name    string
+		data    []byte
+		want    *AuthenticatorData
+		wantErr error
+	}{
+		{
+			"assertion",
+			header(FlagUserPresent),
+			&AuthenticatorData{RPIDHash: rpIDHash[:], Flags: FlagUserPresent, SignCount: 258},
+			nil,
+		},
+		{
+			"attested-credential-data",
+			append(header(FlagUserPresent|FlagAttestedCredentialData), attested...),
+			&AuthenticatorData{
+				RPIDHash:  rpIDHash[:],
+				Flags:     FlagUserPresent | FlagAttestedCredentialData,
+				SignCount: 258,
+				AttestedCredentialData: &AttestedCredentialData{
+					AAGUID:       aaguid,
+					CredentialID: credentialID,
+					PublicKey:    secp256r1test.AlicePublicKey,
+				},
+			},
+			nil,
+		},
+		{
+			"attested-credential-data-and-extensions",
+			append(append(header(FlagAttestedCredentialData|FlagExtensionData), attested...), extensions...),
+			&AuthenticatorData{
+				RPIDHash:  rpIDHash[:],
+				Flags:     FlagAttestedCredentialData | FlagExtensionData,
+				SignCount: 258,
+				AttestedCredentialData: &AttestedCredentialData{
+					AAGUID:       aaguid,
+					CredentialID: credentialID,
+					PublicKey:    secp256r1test.AlicePublicKey,
+				},
+				Extensions: extensions,
+			},
+			nil,
+		},
+		{
+			"err-too-short",
+			header(FlagUserPresent)[:36],
+			nil,
+			ErrInvalidAuthenticatorData,
+		},
+		{
+			"err-trailing-data",
+			append(header(FlagUserPresent), 0),
+			nil,
+			ErrInvalidAuthenticatorData,
+		},
+		{
+			"err-missing-attested-credential-data",
+			header(FlagAttestedCredentialData),
+			nil,
+			ErrInvalidAuthenticatorData,
+		},
+		{
+			"err-truncated-credential-id",
+			append(header(FlagAttestedCredentialData), attested[:20]...),
+			nil,
+			ErrInvalidAuthenticatorData,
+		},
+		{
+			"err-truncated-public-key",
+			append(header(FlagAttestedCredentialData), attested[:len(attested)-1]...),
+			nil,
+			ErrInvalidAuthenticatorData,
+		},
+		{
+			"err-missing-extensions",
+			header(FlagExtensionData),
+			nil,
+			ErrInvalidAuthenticatorData,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseAuthenticatorData(tt.data)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestAuthenticatorData_Flags(t *testing.T) {
+	authData := AuthenticatorData{Flags: FlagUserPresent}
+	assert.True(t, authData.UserPresent())
+	assert.False(t, authData.UserVerified())
+
+	authData.Flags |= FlagUserVerified
+	assert.True(t, authData.UserVerified())
+}
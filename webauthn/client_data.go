@@ -0,0 +1,43 @@
+package webauthn
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Client data types, see https://www.w3.org/TR/webauthn-2/#dom-collectedclientdata-type.
+const (
+	ClientDataTypeCreate = "webauthn.create"
+	ClientDataTypeGet    = "webauthn.get"
+)
+
+// CollectedClientData is the client data the browser collected and the authenticator signed the hash of,
+// see https://www.w3.org/TR/webauthn-2/#dictdef-collectedclientdata.
+type CollectedClientData struct {
+	Type        string `json:"type"`
+	Challenge   string `json:"challenge"`
+	Origin      string `json:"origin"`
+	CrossOrigin bool   `json:"crossOrigin,omitempty"`
+}
+
+// ParseClientData parses the clientDataJSON.
+func ParseClientData(clientDataJSON []byte) (*CollectedClientData, error) {
+	clientData := &CollectedClientData{}
+	if err := json.Unmarshal(clientDataJSON, clientData); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidClientData, err)
+	}
+
+	return clientData, nil
+}
+
+// ChallengeBytes returns the decoded base64url challenge.
+func (c CollectedClientData) ChallengeBytes() ([]byte, error) {
+	challenge, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(c.Challenge, "="))
+	if err != nil {
+		return nil, fmt.Errorf("%w: challenge: %s", ErrInvalidClientData, err)
+	}
+
+	return challenge, nil
+}
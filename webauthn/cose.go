@@ -0,0 +1,83 @@
+package webauthn
+
+import (
+	"crypto/elliptic"
+	"fmt"
+	"math/big"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/mailchain/go-crypto"
+	"github.com/mailchain/go-crypto/ed25519"
+	"github.com/mailchain/go-crypto/secp256r1"
+)
+
+// COSE key types, curves and algorithms, see https://www.iana.org/assignments/cose/cose.xhtml.
+const (
+	coseKeyTypeOKP = 1
+	coseKeyTypeEC2 = 2
+
+	coseCurveP256    = 1
+	coseCurveEd25519 = 6
+
+	// AlgorithmES256 is the COSE algorithm identifier of ECDSA using P-256 and SHA-256.
+	AlgorithmES256 = -7
+	// AlgorithmEdDSA is the COSE algorithm identifier of EdDSA.
+	AlgorithmEdDSA = -8
+)
+
+type coseKey struct {
+	KeyType   int    `cbor:"1,keyasint"`
+	Algorithm int    `cbor:"3,keyasint,omitempty"`
+	Curve     int    `cbor:"-1,keyasint,omitempty"`
+	X         []byte `cbor:"-2,keyasint,omitempty"`
+	Y         []byte `cbor:"-3,keyasint,omitempty"`
+}
+
+var coseDecMode, _ = cbor.DecOptions{DupMapKey: cbor.DupMapKeyEnforcedAPF}.DecMode() //nolint: gochecknoglobals
+
+// ParseCOSEKey parses the CBOR encoded COSE_Key of a credential public key.
+// EC2 keys on P-256 with ES256 are returned as *secp256r1.PublicKey and OKP keys on Ed25519 with EdDSA as *ed25519.PublicKey.
+// The alg parameter is required, keys without it are rejected as the signature algorithm would be unknown.
+func ParseCOSEKey(data []byte) (crypto.PublicKey, error) {
+	key := coseKey{}
+	if err := coseDecMode.Unmarshal(data, &key); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidCOSEKey, err)
+	}
+
+	switch {
+	case key.KeyType == coseKeyTypeEC2 && key.Curve == coseCurveP256:
+		if key.Algorithm != AlgorithmES256 {
+			return nil, fmt.Errorf("%w: algorithm %d", ErrUnsupportedKey, key.Algorithm)
+		}
+
+		return ec2PublicKey(key)
+	case key.KeyType == coseKeyTypeOKP && key.Curve == coseCurveEd25519:
+		if key.Algorithm != AlgorithmEdDSA {
+			return nil, fmt.Errorf("%w: algorithm %d", ErrUnsupportedKey, key.Algorithm)
+		}
+
+		publicKey, err := ed25519.PublicKeyFromBytes(key.X)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidCOSEKey, err)
+		}
+
+		return publicKey, nil
+	default:
+		return nil, fmt.Errorf("%w: key type %d curve %d", ErrUnsupportedKey, key.KeyType, key.Curve)
+	}
+}
+
+func ec2PublicKey(key coseKey) (crypto.PublicKey, error) {
+	if len(key.X) != 32 || len(key.Y) != 32 {
+		return nil, fmt.Errorf("%w: coordinates must be 32 bytes", ErrInvalidCOSEKey)
+	}
+
+	curve := elliptic.P256()
+	x, y := new(big.Int).SetBytes(key.X), new(big.Int).SetBytes(key.Y)
+
+	if !curve.IsOnCurve(x, y) {
+		return nil, fmt.Errorf("%w: point is not on the curve", ErrInvalidCOSEKey)
+	}
+
+	return secp256r1.PublicKeyFromBytes(elliptic.MarshalCompressed(curve, x, y))
+}
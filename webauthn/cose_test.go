@@ -0,0 +1,156 @@
+package webauthn
+
+import (
+	"testing"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/mailchain/go-crypto"
+	"github.com/mailchain/go-crypto/ed25519/ed25519test"
+	"github.com/mailchain/go-crypto/secp256r1"
+	"github.com/mailchain/go-crypto/secp256r1/secp256r1test"
+	"github.com/stretchr/testify/assert"
+)
+
+func es256COSEKey(pk crypto.PublicKey) map[int]interface{} {
+	key := pk.(*secp256r1.PublicKey).Key
+
+	return map[int]interface{}{
+		1:  coseKeyTypeEC2,
+		3:  AlgorithmES256,
+		-1: coseCurveP256,
+		-2: key.X.FillBytes(make([]byte, 32)),
+		-3: key.Y.FillBytes(make([]byte, 32)),
+	}
+}
+
+func eddsaCOSEKey(pk crypto.PublicKey) map[int]interface{} {
+	return map[int]interface{}{
+		1:  coseKeyTypeOKP,
+		3:  AlgorithmEdDSA,
+		-1: coseCurveEd25519,
+		-2: pk.Bytes(),
+	}
+}
+
+func mustMarshalCBOR(v interface{}) []byte {
+	data, err := cbor.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+
+	return data
+}
+
+func TestParseCOSEKey(t *testing.T) {
+	tests := []struct {
+		name    string
+		key     func() map[int]interface{}
+		want    crypto.PublicKey
+		wantErr error
+	}{
+		{
+			"es256",
+			func() map[int]interface{} { return es256COSEKey(secp256r1test.AlicePublicKey) },
+			secp256r1test.AlicePublicKey,
+			nil,
+		},
+		{
+			"eddsa",
+			func() map[int]interface{} { return eddsaCOSEKey(ed25519test.AlicePublicKey) },
+			ed25519test.AlicePublicKey,
+			nil,
+		},
+		{
+			"err-es256-not-on-curve",
+			func() map[int]interface{} {
+				key := es256COSEKey(secp256r1test.AlicePublicKey)
+				key[-3].([]byte)[31] ^= 1
+				return key
+			},
+			nil,
+			ErrInvalidCOSEKey,
+		},
+		{
+			"err-es256-short-coordinate",
+			func() map[int]interface{} {
+				key := es256COSEKey(secp256r1test.AlicePublicKey)
+				key[-2] = key[-2].([]byte)[1:]
+				return key
+			},
+			nil,
+			ErrInvalidCOSEKey,
+		},
+		{
+			"err-es256-wrong-algorithm",
+			func() map[int]interface{} {
+				key := es256COSEKey(secp256r1test.AlicePublicKey)
+				key[3] = AlgorithmEdDSA
+				return key
+			},
+			nil,
+			ErrUnsupportedKey,
+		},
+		{
+			"err-es256-missing-alg",
+			func() map[int]interface{} {
+				key := es256COSEKey(secp256r1test.AlicePublicKey)
+				delete(key, 3)
+				return key
+			},
+			nil,
+			ErrUnsupportedKey,
+		},
+		{
+			"err-eddsa-missing-alg",
+			func() map[int]interface{} {
+				key := eddsaCOSEKey(ed25519test.AlicePublicKey)
+				delete(key, 3)
+				return key
+			},
+			nil,
+			ErrUnsupportedKey,
+		},
+		{
+			"err-eddsa-short-key",
+			func() map[int]interface{} {
+				key := eddsaCOSEKey(ed25519test.AlicePublicKey)
+				key[-2] = key[-2].([]byte)[1:]
+				return key
+			},
+			nil,
+			ErrInvalidCOSEKey,
+		},
+		{
+			"err-rsa",
+			func() map[int]interface{} { return map[int]interface{}{1: 3, 3: -257} },
+			nil,
+			ErrUnsupportedKey,
+		},
+		{
+			"err-secp256k1",
+			func() map[int]interface{} {
+				key := es256COSEKey(secp256r1test.AlicePublicKey)
+				key[-1] = 8
+				return key
+			},
+			nil,
+			ErrUnsupportedKey,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseCOSEKey(mustMarshalCBOR(tt.key()))
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestParseCOSEKey_Invalid(t *testing.T) {
+	_, err := ParseCOSEKey([]byte{0xa1})
+	assert.ErrorIs(t, err, ErrInvalidCOSEKey)
+
+	duplicate := []byte{0xa2, 0x01, 0x02, 0x01, 0x01}
+	_, err = ParseCOSEKey(duplicate)
+	assert.ErrorIs(t, err, ErrInvalidCOSEKey)
+}
@@ -0,0 +1,163 @@
+// Package webauthn verifies WebAuthn assertions, created by passkeys and security keys, with secp256r1 (ES256)
+// and ed25519 (EdDSA) credential public keys, see https://www.w3.org/TR/webauthn-2/#sctn-verifying-assertion.
+package webauthn
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+
+	"github.com/mailchain/go-crypto"
+	"github.com/mailchain/go-crypto/ed25519"
+	"github.com/mailchain/go-crypto/secp256r1"
+)
+
+var (
+	// ErrInvalidAuthenticatorData is returned when the authenticator data is malformed.
+	ErrInvalidAuthenticatorData = errors.New("webauthn: invalid authenticator data")
+	// ErrInvalidClientData is returned when the client data JSON is malformed.
+	ErrInvalidClientData = errors.New("webauthn: invalid client data")
+	// ErrInvalidCOSEKey is returned when a COSE_Key is malformed.
+	ErrInvalidCOSEKey = errors.New("webauthn: invalid COSE key")
+	// ErrUnsupportedKey is returned when the key type, curve or algorithm is not supported.
+	ErrUnsupportedKey = errors.New("webauthn: unsupported key")
+	// ErrClientDataType is returned when the client data type is not webauthn.get.
+	ErrClientDataType = errors.New("webauthn: client data type must be " + ClientDataTypeGet)
+	// ErrChallengeMismatch is returned when the challenge does not match the expected challenge.
+	ErrChallengeMismatch = errors.New("webauthn: challenge mismatch")
+	// ErrOriginMismatch is returned when the origin is not one of the allowed origins.
+	ErrOriginMismatch = errors.New("webauthn: origin not allowed")
+	// ErrRPIDHashMismatch is returned when the authenticator data is not scoped to the relying party ID.
+	ErrRPIDHashMismatch = errors.New("webauthn: relying party ID hash mismatch")
+	// ErrUserNotPresent is returned when the user present flag is not set.
+	ErrUserNotPresent = errors.New("webauthn: user not present")
+	// ErrUserNotVerified is returned when user verification is required and the user verified flag is not set.
+	ErrUserNotVerified = errors.New("webauthn: user not verified")
+	// ErrSignCountNotIncreased is returned when the signature counter did not increase, this can indicate a cloned authenticator.
+	ErrSignCountNotIncreased = errors.New("webauthn: signature counter did not increase")
+	// ErrInvalidSignature is returned when the assertion signature is not valid for the credential public key.
+	ErrInvalidSignature = errors.New("webauthn: invalid signature")
+)
+
+// Assertion is the response of navigator.credentials.get, see https://www.w3.org/TR/webauthn-2/#authenticatorassertionresponse.
+type Assertion struct {
+	AuthenticatorData []byte
+	ClientDataJSON    []byte
+	// Signature is ASN.1 DER encoded for ES256 and 64 bytes for EdDSA.
+	Signature []byte
+}
+
+// VerifyOptions are the values the assertion is checked against.
+type VerifyOptions struct {
+	// Challenge is the challenge that was sent to the client.
+	Challenge []byte
+	// Origins that are allowed, for example https://app.mailchain.com.
+	Origins []string
+	// RPID is the relying party ID, for example mailchain.com.
+	RPID string
+	// RequireUserVerification requires the user verified flag to be set.
+	RequireUserVerification bool
+	// SignCount is the stored signature counter of the credential, 0 when the authenticator has not used a counter.
+	SignCount uint32
+}
+
+// VerifyAssertion verifies the assertion was created by the credential public key, a *secp256r1.PublicKey for ES256
+// or *ed25519.PublicKey for EdDSA, and that it matches the options.
+// The parsed authenticator data is returned so the new signature counter can be stored.
+func VerifyAssertion(publicKey crypto.PublicKey, assertion Assertion, opts VerifyOptions) (*AuthenticatorData, error) {
+	clientData, err := ParseClientData(assertion.ClientDataJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkClientData(clientData, opts); err != nil {
+		return nil, err
+	}
+
+	authData, err := ParseAuthenticatorData(assertion.AuthenticatorData)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkAuthenticatorData(authData, opts); err != nil {
+		return nil, err
+	}
+
+	clientDataHash := sha256.Sum256(assertion.ClientDataJSON)
+	signedData := append(append([]byte{}, assertion.AuthenticatorData...), clientDataHash[:]...)
+
+	verified, err := verifySignature(publicKey, signedData, assertion.Signature)
+	if err != nil {
+		return nil, err
+	}
+
+	if !verified {
+		return nil, ErrInvalidSignature
+	}
+
+	return authData, nil
+}
+
+func checkClientData(clientData *CollectedClientData, opts VerifyOptions) error {
+	if clientData.Type != ClientDataTypeGet {
+		return ErrClientDataType
+	}
+
+	challenge, err := clientData.ChallengeBytes()
+	if err != nil {
+		return err
+	}
+
+	if len(opts.Challenge) == 0 || subtle.ConstantTimeCompare(challenge, opts.Challenge) != 1 {
+		return ErrChallengeMismatch
+	}
+
+	for _, origin := range opts.Origins {
+		if clientData.Origin == origin {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%w: %q", ErrOriginMismatch, clientData.Origin)
+}
+
+func checkAuthenticatorData(authData *AuthenticatorData, opts VerifyOptions) error {
+	rpIDHash := sha256.Sum256([]byte(opts.RPID))
+	if !bytes.Equal(authData.RPIDHash, rpIDHash[:]) {
+		return ErrRPIDHashMismatch
+	}
+
+	if !authData.UserPresent() {
+		return ErrUserNotPresent
+	}
+
+	if opts.RequireUserVerification && !authData.UserVerified() {
+		return ErrUserNotVerified
+	}
+
+	// https://www.w3.org/TR/webauthn-2/#sctn-sign-counter
+	if (authData.SignCount != 0 || opts.SignCount != 0) && authData.SignCount <= opts.SignCount {
+		return ErrSignCountNotIncreased
+	}
+
+	return nil
+}
+
+func verifySignature(publicKey crypto.PublicKey, signedData, sig []byte) (bool, error) {
+	switch pk := publicKey.(type) {
+	case *secp256r1.PublicKey:
+		digest := sha256.Sum256(signedData)
+		return pk.VerifyDER(digest[:], sig), nil
+	case secp256r1.PublicKey:
+		digest := sha256.Sum256(signedData)
+		return pk.VerifyDER(digest[:], sig), nil
+	case *ed25519.PublicKey:
+		return pk.Verify(signedData, sig), nil
+	case ed25519.PublicKey:
+		return pk.Verify(signedData, sig), nil
+	default:
+		return false, fmt.Errorf("%w: %T", ErrUnsupportedKey, publicKey)
+	}
+}
@@ -0,0 +1,251 @@
+package webauthn
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"testing"
+
+	"github.com/mailchain/go-crypto"
+	"github.com/mailchain/go-crypto/ed25519/ed25519test"
+	"github.com/mailchain/go-crypto/secp256r1"
+	"github.com/mailchain/go-crypto/secp256r1/secp256r1test"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testRPID   = "mailchain.com"
+	testOrigin = "https://app.mailchain.com"
+)
+
+var testChallenge = []byte("a random challenge from the server") //nolint: gochecknoglobals
+
+type testAssertion struct {
+	clientData CollectedClientData
+	rpID       string
+	flags      byte
+	signCount  uint32
+}
+
+func newTestAssertion() testAssertion {
+	return testAssertion{
+		clientData: CollectedClientData{
+			Type:      ClientDataTypeGet,
+			Challenge: base64.RawURLEncoding.EncodeToString(testChallenge),
+			Origin:    testOrigin,
+		},
+		rpID:      testRPID,
+		flags:     FlagUserPresent | FlagUserVerified,
+		signCount: 5,
+	}
+}
+
+func (a testAssertion) sign(t *testing.T, key crypto.PrivateKey) Assertion {
+	clientDataJSON, err := json.Marshal(a.clientData)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	rpIDHash := sha256.Sum256([]byte(a.rpID))
+	authData := append(rpIDHash[:], a.flags, 0, 0, 0, 0)
+	binary.BigEndian.PutUint32(authData[33:], a.signCount)
+
+	clientDataHash := sha256.Sum256(clientDataJSON)
+	signedData := append(append([]byte{}, authData...), clientDataHash[:]...)
+
+	var sig []byte
+
+	switch k := key.(type) {
+	case *secp256r1.PrivateKey:
+		digest := sha256.Sum256(signedData)
+		fixed, err := k.Sign(digest[:])
+		assert.NoError(t, err)
+		sig, err = secp256r1.SignatureToDER(fixed)
+		assert.NoError(t, err)
+	default:
+		sig, err = k.Sign(signedData)
+		assert.NoError(t, err)
+	}
+
+	return Assertion{AuthenticatorData: authData, ClientDataJSON: clientDataJSON, Signature: sig}
+}
+
+func testOptions() VerifyOptions {
+	return VerifyOptions{
+		Challenge: testChallenge,
+		Origins:   []string{"https://mailchain.com", testOrigin},
+		RPID:      testRPID,
+		SignCount: 4,
+	}
+}
+
+func TestVerifyAssertion(t *testing.T) {
+	tests := []struct {
+		name       string
+		signingKey crypto.PrivateKey
+		publicKey  crypto.PublicKey
+		setup      func(a *testAssertion, opts *VerifyOptions)
+		tamper     func(assertion *Assertion)
+		wantErr    error
+	}{
+		{
+			name:       "es256",
+			signingKey: secp256r1test.AlicePrivateKey,
+			publicKey:  secp256r1test.AlicePublicKey,
+		},
+		{
+			name:       "eddsa",
+			signingKey: ed25519test.AlicePrivateKey,
+			publicKey:  ed25519test.AlicePublicKey,
+		},
+		{
+			name:       "no-sign-counter",
+			signingKey: secp256r1test.AlicePrivateKey,
+			publicKey:  secp256r1test.AlicePublicKey,
+			setup:      func(a *testAssertion, opts *VerifyOptions) { a.signCount, opts.SignCount = 0, 0 },
+		},
+		{
+			name:       "user-verification-not-required",
+			signingKey: secp256r1test.AlicePrivateKey,
+			publicKey:  secp256r1test.AlicePublicKey,
+			setup:      func(a *testAssertion, _ *VerifyOptions) { a.flags = FlagUserPresent },
+		},
+		{
+			name:       "err-wrong-key",
+			signingKey: secp256r1test.AlicePrivateKey,
+			publicKey:  secp256r1test.BobPublicKey,
+			wantErr:    ErrInvalidSignature,
+		},
+		{
+			name:       "err-eddsa-wrong-key",
+			signingKey: ed25519test.AlicePrivateKey,
+			publicKey:  ed25519test.BobPublicKey,
+			wantErr:    ErrInvalidSignature,
+		},
+		{
+			name:       "err-modified-authenticator-data",
+			signingKey: secp256r1test.AlicePrivateKey,
+			publicKey:  secp256r1test.AlicePublicKey,
+			tamper:     func(assertion *Assertion) { assertion.AuthenticatorData[36]++ },
+			wantErr:    ErrInvalidSignature,
+		},
+		{
+			name:       "err-fixed-width-signature",
+			signingKey: secp256r1test.AlicePrivateKey,
+			publicKey:  secp256r1test.AlicePublicKey,
+			tamper: func(assertion *Assertion) {
+				assertion.Signature, _ = secp256r1.SignatureFromDER(assertion.Signature)
+			},
+			wantErr: ErrInvalidSignature,
+		},
+		{
+			name:       "err-type",
+			signingKey: secp256r1test.AlicePrivateKey,
+			publicKey:  secp256r1test.AlicePublicKey,
+			setup:      func(a *testAssertion, _ *VerifyOptions) { a.clientData.Type = ClientDataTypeCreate },
+			wantErr:    ErrClientDataType,
+		},
+		{
+			name:       "err-challenge",
+			signingKey: secp256r1test.AlicePrivateKey,
+			publicKey:  secp256r1test.AlicePublicKey,
+			setup:      func(_ *testAssertion, opts *VerifyOptions) { opts.Challenge = []byte("another challenge") },
+			wantErr:    ErrChallengeMismatch,
+		},
+		{
+			name:       "err-empty-challenge",
+			signingKey: secp256r1test.AlicePrivateKey,
+			publicKey:  secp256r1test.AlicePublicKey,
+			setup:      func(a *testAssertion, opts *VerifyOptions) { a.clientData.Challenge, opts.Challenge = "", nil },
+			wantErr:    ErrChallengeMismatch,
+		},
+		{
+			name:       "err-origin",
+			signingKey: secp256r1test.AlicePrivateKey,
+			publicKey:  secp256r1test.AlicePublicKey,
+			setup:      func(a *testAssertion, _ *VerifyOptions) { a.clientData.Origin = "https://mailchain.com.evil" },
+			wantErr:    ErrOriginMismatch,
+		},
+		{
+			name:       "err-rp-id",
+			signingKey: secp256r1test.AlicePrivateKey,
+			publicKey:  secp256r1test.AlicePublicKey,
+			setup:      func(a *testAssertion, _ *VerifyOptions) { a.rpID = "evil.com" },
+			wantErr:    ErrRPIDHashMismatch,
+		},
+		{
+			name:       "err-user-not-present",
+			signingKey: secp256r1test.AlicePrivateKey,
+			publicKey:  secp256r1test.AlicePublicKey,
+			setup:      func(a *testAssertion, _ *VerifyOptions) { a.flags = FlagUserVerified },
+			wantErr:    ErrUserNotPresent,
+		},
+		{
+			name:       "err-user-not-verified",
+			signingKey: secp256r1test.AlicePrivateKey,
+			publicKey:  secp256r1test.AlicePublicKey,
+			setup: func(a *testAssertion, opts *VerifyOptions) {
+				a.flags = FlagUserPresent
+				opts.RequireUserVerification = true
+			},
+			wantErr: ErrUserNotVerified,
+		},
+		{
+			name:       "err-sign-count-not-increased",
+			signingKey: secp256r1test.AlicePrivateKey,
+			publicKey:  secp256r1test.AlicePublicKey,
+			setup:      func(_ *testAssertion, opts *VerifyOptions) { opts.SignCount = 5 },
+			wantErr:    ErrSignCountNotIncreased,
+		},
+		{
+			name:       "err-sign-count-reset",
+			signingKey: secp256r1test.AlicePrivateKey,
+			publicKey:  secp256r1test.AlicePublicKey,
+			setup:      func(a *testAssertion, _ *VerifyOptions) { a.signCount = 0 },
+			wantErr:    ErrSignCountNotIncreased,
+		},
+		{
+			name:       "err-truncated-authenticator-data",
+			signingKey: secp256r1test.AlicePrivateKey,
+			publicKey:  secp256r1test.AlicePublicKey,
+			tamper:     func(assertion *Assertion) { assertion.AuthenticatorData = assertion.AuthenticatorData[:36] },
+			wantErr:    ErrInvalidAuthenticatorData,
+		},
+		{
+			name:       "err-invalid-client-data",
+			signingKey: secp256r1test.AlicePrivateKey,
+			publicKey:  secp256r1test.AlicePublicKey,
+			tamper:     func(assertion *Assertion) { assertion.ClientDataJSON = []byte("{") },
+			wantErr:    ErrInvalidClientData,
+		},
+		{
+			name:       "err-unsupported-key",
+			signingKey: secp256r1test.AlicePrivateKey,
+			publicKey:  nil,
+			wantErr:    ErrUnsupportedKey,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := newTestAssertion()
+			opts := testOptions()
+			if tt.setup != nil {
+				tt.setup(&a, &opts)
+			}
+
+			assertion := a.sign(t, tt.signingKey)
+			if tt.tamper != nil {
+				tt.tamper(&assertion)
+			}
+
+			got, err := VerifyAssertion(tt.publicKey, assertion, opts)
+			assert.ErrorIs(t, err, tt.wantErr)
+			if tt.wantErr != nil {
+				assert.Nil(t, got)
+				return
+			}
+			assert.Equal(t, a.signCount, got.SignCount)
+		})
+	}
+}